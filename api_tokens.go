@@ -0,0 +1,76 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/account"
+	"github.com/jehiah/legislation.support/internal/apiresponse"
+	log "github.com/sirupsen/logrus"
+)
+
+// APITokensPost creates and revokes the signed in user's API tokens
+// POST /data/api_tokens
+//
+// action=create name=... scope=... (repeated)
+// action=revoke id=...
+func (a *App) APITokensPost(w http.ResponseWriter, r *http.Request) {
+	r.ParseMultipartForm(1 << 20)
+	ctx := r.Context()
+	uid := a.User(r)
+	if uid == "" {
+		apiresponse.Error(w, "Permission Denied", http.StatusForbidden)
+		return
+	}
+	action := r.Form.Get("action")
+	logFields := log.Fields{"uid": uid, "action": action}
+
+	switch action {
+	case "create":
+		var scopes []account.Scope
+		for _, s := range r.Form["scope"] {
+			scopes = append(scopes, account.Scope(s))
+		}
+		secret, t, err := account.NewAPIToken(uid, r.Form.Get("name"), scopes, time.Now().UTC())
+		if err != nil {
+			apiresponse.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		if err = a.CreateAPIToken(ctx, t); err != nil {
+			log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+			apiresponse.InternalError500(w)
+			return
+		}
+		log.WithContext(ctx).WithFields(logFields).WithField("token", t.ID).Infof("created API token")
+		apiresponse.OK200(w, struct {
+			Message
+			Token string `json:"token"`
+		}{
+			Message: Message{Success: "Created API token " + t.Name + ". Copy it now; it won't be shown again."},
+			Token:   secret,
+		})
+	case "revoke":
+		tokens, err := a.GetAPITokens(ctx, uid)
+		if err != nil {
+			log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+			apiresponse.InternalError500(w)
+			return
+		}
+		id := r.Form.Get("id")
+		for _, t := range tokens {
+			if t.ID != id {
+				continue
+			}
+			if err = a.DeleteAPIToken(ctx, t.Hash); err != nil {
+				log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+				apiresponse.InternalError500(w)
+				return
+			}
+			apiresponse.OK200(w, Message{Success: "Revoked API token " + t.Name})
+			return
+		}
+		apiresponse.Error(w, "API token not found", http.StatusNotFound)
+	default:
+		apiresponse.BadRequest400(w, "INVALID_ACTION")
+	}
+}
@@ -0,0 +1,531 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/account"
+	"github.com/jehiah/legislation.support/internal/apiresponse"
+	"github.com/jehiah/legislation.support/internal/legislature"
+	"github.com/jehiah/legislation.support/internal/resolvers"
+	log "github.com/sirupsen/logrus"
+)
+
+// apiHandler is a /api/v1 handler for a request authenticated with an APIToken
+type apiHandler func(w http.ResponseWriter, r *http.Request, t account.APIToken)
+
+// api authenticates the "Authorization: Bearer ..." API token and checks it has scope
+func (a *App) api(scope account.Scope, h apiHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		secret, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || !account.IsAPIToken(secret) {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="legislation.support"`)
+			apiresponse.Error(w, "API token required", http.StatusUnauthorized)
+			return
+		}
+		t, err := a.GetAPIToken(ctx, account.HashAPIToken(secret))
+		if err != nil {
+			log.WithContext(ctx).Errorf("%#v", err)
+			apiresponse.Error(w, apiresponse.StatusText(500), http.StatusInternalServerError)
+			return
+		}
+		if t == nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="legislation.support", error="invalid_token"`)
+			apiresponse.Error(w, "invalid API token", http.StatusUnauthorized)
+			return
+		}
+		if !t.HasScope(scope) {
+			apiresponse.Error(w, "API token is missing scope "+string(scope), http.StatusForbidden)
+			return
+		}
+		// record use at most hourly
+		if now := time.Now().UTC(); now.Sub(t.LastUsed) > time.Hour {
+			t.LastUsed = now
+			if err = a.UpdateAPIToken(ctx, *t); err != nil {
+				log.WithContext(ctx).WithField("uid", t.UID).Warnf("updating API token %#v", err)
+			}
+		}
+		h(w, r, *t)
+	}
+}
+
+// decodeJSON reads a JSON request body into v and writes a 400 when it's invalid
+func decodeJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	d := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20))
+	d.DisallowUnknownFields()
+	if err := d.Decode(v); err != nil {
+		apiresponse.Error(w, "invalid JSON: "+err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// apiProfile loads the {profile} in the request path. A profile the token can't view is Not Found.
+func (a *App) apiProfile(w http.ResponseWriter, r *http.Request, t account.APIToken) (*account.Profile, bool) {
+	ctx := r.Context()
+	profileID := account.ProfileID(r.PathValue("profile"))
+	profile, err := a.GetProfile(ctx, profileID)
+	if err != nil {
+		log.WithContext(ctx).WithFields(log.Fields{"uid": t.UID, "profileID": profileID}).Errorf("%#v", err)
+		apiresponse.Error(w, apiresponse.StatusText(500), http.StatusInternalServerError)
+		return nil, false
+	}
+	if profile == nil || !profile.CanView(t.UID, "") {
+		apiresponse.Error(w, apiresponse.StatusText(404), http.StatusNotFound)
+		return nil, false
+	}
+	return profile, true
+}
+
+// APIProfile is the /api/v1 representation of a profile
+type APIProfile struct {
+	ID           account.ProfileID
+	Name         string
+	Description  string
+	Private      bool
+	Role         account.Role `json:",omitempty"` // the role of the API token user
+	URL          string
+	Created      time.Time
+	LastModified time.Time
+	account.ScorecardOptions
+	ScoringPolicy legislature.ScoringPolicy
+}
+
+func newAPIProfile(p account.Profile, u account.UID) APIProfile {
+	return APIProfile{
+		ID:               p.ID,
+		Name:             p.Name,
+		Description:      p.Description,
+		Private:          p.Private,
+		Role:             p.Role(u),
+		URL:              p.FullLink(),
+		Created:          p.Created,
+		LastModified:     p.LastModified,
+		ScorecardOptions: p.ScorecardOptions,
+		ScoringPolicy:    p.Policy(),
+	}
+}
+
+// APIProfileEdit is the body of a profile update; omitted fields are unchanged
+type APIProfileEdit struct {
+	Name              *string
+	Description       *string
+	Private           *bool
+	HideDistrict      *bool
+	HideBillStatus    *bool
+	HideSupportOppose *bool
+	ShowPercent       *bool
+	HideParty         *bool
+	// ScoringPolicy fields are merged with the current policy; null resets to the default
+	ScoringPolicy *legislature.ScoringPolicy
+}
+
+// APIBookmarkEdit is the body of a bookmark create or update; omitted fields are unchanged
+type APIBookmarkEdit struct {
+	Legislation string `json:",omitempty"` // a URL or citation (i.e. "S1234"); create only
+	Position    *account.Position
+	Oppose      *bool // Deprecated: use Position
+	Notes       *string
+	Tags        []string
+	Rank        *int // priority; 1 sorts first, 0 is unranked
+	Weight      *int // scorecard weight; 0 is 1
+}
+
+func (e APIBookmarkEdit) validate() error {
+	return e.edit().Validate()
+}
+
+// edit is the change to a bookmark; omitted fields are nil
+func (e APIBookmarkEdit) edit() account.BookmarkEdit {
+	o := account.BookmarkEdit{
+		Position: e.Position,
+		Rank:     e.Rank,
+		Weight:   e.Weight,
+	}
+	if o.Position == nil && e.Oppose != nil {
+		o.Position = ptr(account.SupportPosition)
+		if *e.Oppose {
+			o.Position = ptr(account.OpposePosition)
+		}
+	}
+	if e.Notes != nil {
+		o.Notes = ptr(strings.TrimSpace(*e.Notes))
+	}
+	if e.Tags != nil {
+		o.Tags = ptr(strings.Fields(strings.Join(e.Tags, " ")))
+	}
+	return o
+}
+
+// TagCount is the number of bookmarks with a tag
+type TagCount struct {
+	Tag   string
+	Count int
+}
+
+// APIScorecard is a scorecard and the whip count for each person
+type APIScorecard struct {
+	*legislature.Scorecard
+	WhipCounts []legislature.PersonWhipCount
+}
+
+// APIProfiles lists the profiles the token user is a member of
+// GET /api/v1/profiles
+func (a *App) APIProfiles(w http.ResponseWriter, r *http.Request, t account.APIToken) {
+	profiles, err := a.GetProfiles(r.Context(), t.UID)
+	if err != nil {
+		log.WithContext(r.Context()).WithField("uid", t.UID).Errorf("%#v", err)
+		apiresponse.Error(w, apiresponse.StatusText(500), http.StatusInternalServerError)
+		return
+	}
+	out := make([]APIProfile, 0, len(profiles))
+	for _, p := range profiles {
+		out = append(out, newAPIProfile(p, t.UID))
+	}
+	apiresponse.OK200(w, out)
+}
+
+// APIProfileCreate creates a profile
+// POST /api/v1/profiles {"ID": ..., "Name": ...}
+func (a *App) APIProfileCreate(w http.ResponseWriter, r *http.Request, t account.APIToken) {
+	var req struct {
+		ID   account.ProfileID
+		Name string
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	profile, err := a.createProfile(r.Context(), t.UID, req.ID, req.Name)
+	switch {
+	case errors.Is(err, errInvalidProfileID):
+		apiresponse.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	case errors.Is(err, errProfileTaken):
+		apiresponse.Error(w, err.Error(), http.StatusConflict)
+		return
+	case err != nil:
+		log.WithContext(r.Context()).WithField("uid", t.UID).Errorf("%#v", err)
+		apiresponse.Error(w, apiresponse.StatusText(500), http.StatusInternalServerError)
+		return
+	}
+	apiresponse.Created201(w, newAPIProfile(*profile, t.UID))
+}
+
+// APIProfile returns a profile
+// GET /api/v1/profiles/{profile}
+func (a *App) APIProfile(w http.ResponseWriter, r *http.Request, t account.APIToken) {
+	profile, ok := a.apiProfile(w, r, t)
+	if !ok {
+		return
+	}
+	apiresponse.OK200(w, newAPIProfile(*profile, t.UID))
+}
+
+// APIProfileUpdate updates profile settings; it requires the owner role
+// PATCH /api/v1/profiles/{profile}
+func (a *App) APIProfileUpdate(w http.ResponseWriter, r *http.Request, t account.APIToken) {
+	profile, ok := a.apiProfile(w, r, t)
+	if !ok {
+		return
+	}
+	if !profile.Role(t.UID).CanManage() {
+		apiresponse.Error(w, "Permission Denied", http.StatusForbidden)
+		return
+	}
+	req := APIProfileEdit{ScoringPolicy: ptr(profile.Policy())}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if req.ScoringPolicy == nil {
+		req.ScoringPolicy = &legislature.DefaultScoringPolicy
+	}
+	if !req.ScoringPolicy.IsValid() {
+		apiresponse.Error(w, fmt.Sprintf("scoring points must be from 0 to %d", legislature.MaxPoints), http.StatusUnprocessableEntity)
+		return
+	}
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			apiresponse.Error(w, "name required", http.StatusUnprocessableEntity)
+			return
+		}
+		profile.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		profile.Description = strings.TrimSpace(*req.Description)
+	}
+	for _, f := range []struct {
+		v   *bool
+		dst *bool
+	}{
+		{req.Private, &profile.Private},
+		{req.HideDistrict, &profile.HideDistrict},
+		{req.HideBillStatus, &profile.HideBillStatus},
+		{req.HideSupportOppose, &profile.HideSupportOppose},
+		{req.ShowPercent, &profile.ShowPercent},
+		{req.HideParty, &profile.HideParty},
+	} {
+		if f.v != nil {
+			*f.dst = *f.v
+		}
+	}
+	profile.SetPolicy(*req.ScoringPolicy)
+	profile.LastModified = time.Now().UTC()
+	if err := a.UpdateProfile(r.Context(), *profile); err != nil {
+		log.WithContext(r.Context()).WithFields(log.Fields{"uid": t.UID, "profileID": profile.ID}).Errorf("%#v", err)
+		apiresponse.Error(w, apiresponse.StatusText(500), http.StatusInternalServerError)
+		return
+	}
+	apiresponse.OK200(w, newAPIProfile(*profile, t.UID))
+}
+
+// APIProfileDelete deletes a profile and its bookmarks; it requires the owner role
+// DELETE /api/v1/profiles/{profile}
+func (a *App) APIProfileDelete(w http.ResponseWriter, r *http.Request, t account.APIToken) {
+	profile, ok := a.apiProfile(w, r, t)
+	if !ok {
+		return
+	}
+	if !profile.Role(t.UID).CanManage() {
+		apiresponse.Error(w, "Permission Denied", http.StatusForbidden)
+		return
+	}
+	logFields := log.Fields{"uid": t.UID, "profileID": profile.ID}
+	if err := a.DeleteProfile(r.Context(), profile.ID); err != nil {
+		log.WithContext(r.Context()).WithFields(logFields).Errorf("%#v", err)
+		apiresponse.Error(w, apiresponse.StatusText(500), http.StatusInternalServerError)
+		return
+	}
+	log.WithContext(r.Context()).WithFields(logFields).Infof("deleted profile")
+	apiresponse.OK200(w, nil)
+}
+
+// apiBookmarks returns the profile and its bookmarks
+func (a *App) apiBookmarks(w http.ResponseWriter, r *http.Request, t account.APIToken) (*account.Profile, account.Bookmarks, bool) {
+	profile, ok := a.apiProfile(w, r, t)
+	if !ok {
+		return nil, nil, false
+	}
+	bookmarks, err := a.GetProfileBookmarks(r.Context(), profile.ID)
+	if err != nil {
+		log.WithContext(r.Context()).WithFields(log.Fields{"uid": t.UID, "profileID": profile.ID}).Errorf("%#v", err)
+		apiresponse.Error(w, apiresponse.StatusText(500), http.StatusInternalServerError)
+		return nil, nil, false
+	}
+	// bookmarks saved before Position existed
+	for i := range bookmarks {
+		bookmarks[i].Position = bookmarks[i].GetPosition()
+	}
+	return profile, bookmarks, true
+}
+
+// apiBookmark finds the {body}/{legislation} bookmark in the request path
+func apiBookmark(w http.ResponseWriter, r *http.Request, bookmarks account.Bookmarks) (*account.Bookmark, bool) {
+	key := account.BookmarkKey(legislature.BodyID(r.PathValue("body")), legislature.LegislationID(r.PathValue("legislation")))
+	for i := range bookmarks {
+		if bookmarks[i].Key() == key {
+			return &bookmarks[i], true
+		}
+	}
+	apiresponse.Error(w, apiresponse.StatusText(404), http.StatusNotFound)
+	return nil, false
+}
+
+// APIBookmarks lists the bookmarks on a profile. ?tag= filters by tag and ?active=true excludes past sessions
+// GET /api/v1/profiles/{profile}/bookmarks
+func (a *App) APIBookmarks(w http.ResponseWriter, r *http.Request, t account.APIToken) {
+	_, bookmarks, ok := a.apiBookmarks(w, r, t)
+	if !ok {
+		return
+	}
+	if r.URL.Query().Get("active") == "true" {
+		bookmarks = bookmarks.Active()
+	}
+	if tag := r.URL.Query().Get("tag"); tag != "" {
+		bookmarks = bookmarks.FilterTag(tag)
+	}
+	sort.Sort(account.SortedBookmarks(bookmarks))
+	if bookmarks == nil {
+		bookmarks = account.Bookmarks{}
+	}
+	apiresponse.OK200(w, bookmarks)
+}
+
+// APIBookmarkCreate adds legislation (by URL or citation) to a profile; an existing bookmark is updated
+// POST /api/v1/profiles/{profile}/bookmarks
+func (a *App) APIBookmarkCreate(w http.ResponseWriter, r *http.Request, t account.APIToken) {
+	profile, ok := a.apiProfile(w, r, t)
+	if !ok {
+		return
+	}
+	if !profile.Role(t.UID).CanEdit() {
+		apiresponse.Error(w, "Permission Denied", http.StatusForbidden)
+		return
+	}
+	var req APIBookmarkEdit
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if strings.TrimSpace(req.Legislation) == "" {
+		apiresponse.Error(w, "Legislation URL or citation required", http.StatusUnprocessableEntity)
+		return
+	}
+	if err := req.validate(); err != nil {
+		apiresponse.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	c := a.saveBookmark(r.Context(), t.UID, profile.ID, strings.TrimSpace(req.Legislation), req.edit())
+	switch {
+	case c.Error != "":
+		apiresponse.Error(w, c.Error, http.StatusUnprocessableEntity)
+	case c.New:
+		apiresponse.Created201(w, c.Bookmark)
+	default:
+		apiresponse.OK200(w, c.Bookmark)
+	}
+}
+
+// APIBookmark returns a bookmark
+// GET /api/v1/profiles/{profile}/bookmarks/{body}/{legislation}
+func (a *App) APIBookmark(w http.ResponseWriter, r *http.Request, t account.APIToken) {
+	_, bookmarks, ok := a.apiBookmarks(w, r, t)
+	if !ok {
+		return
+	}
+	b, ok := apiBookmark(w, r, bookmarks)
+	if !ok {
+		return
+	}
+	apiresponse.OK200(w, b)
+}
+
+// APIBookmarkUpdate updates the notes, tags, position, rank or weight of a bookmark
+// PATCH /api/v1/profiles/{profile}/bookmarks/{body}/{legislation}
+func (a *App) APIBookmarkUpdate(w http.ResponseWriter, r *http.Request, t account.APIToken) {
+	profile, bookmarks, ok := a.apiBookmarks(w, r, t)
+	if !ok {
+		return
+	}
+	if !profile.Role(t.UID).CanEdit() {
+		apiresponse.Error(w, "Permission Denied", http.StatusForbidden)
+		return
+	}
+	b, ok := apiBookmark(w, r, bookmarks)
+	if !ok {
+		return
+	}
+	var req APIBookmarkEdit
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if req.Legislation != "" {
+		apiresponse.Error(w, "Legislation can't be changed", http.StatusUnprocessableEntity)
+		return
+	}
+	if err := req.validate(); err != nil {
+		apiresponse.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	old := *b
+	req.edit().Apply(b)
+	b.LastModified = time.Now().UTC()
+	if err := a.UpdateBookmark(r.Context(), profile.ID, *b); err != nil {
+		log.WithContext(r.Context()).WithFields(log.Fields{"uid": t.UID, "profileID": profile.ID}).Errorf("%#v", err)
+		apiresponse.Error(w, apiresponse.StatusText(500), http.StatusInternalServerError)
+		return
+	}
+	a.recordBookmarkHistory(r.Context(), t.UID, profile.ID, &old, b)
+	apiresponse.OK200(w, b)
+}
+
+// APIBookmarkDelete removes a bookmark from a profile
+// DELETE /api/v1/profiles/{profile}/bookmarks/{body}/{legislation}
+func (a *App) APIBookmarkDelete(w http.ResponseWriter, r *http.Request, t account.APIToken) {
+	profile, bookmarks, ok := a.apiBookmarks(w, r, t)
+	if !ok {
+		return
+	}
+	if !profile.Role(t.UID).CanEdit() {
+		apiresponse.Error(w, "Permission Denied", http.StatusForbidden)
+		return
+	}
+	b, ok := apiBookmark(w, r, bookmarks)
+	if !ok {
+		return
+	}
+	if err := a.DeleteBookmark(r.Context(), profile.ID, b.BodyID, b.LegislationID); err != nil {
+		log.WithContext(r.Context()).WithFields(log.Fields{"uid": t.UID, "profileID": profile.ID}).Errorf("%#v", err)
+		apiresponse.Error(w, apiresponse.StatusText(500), http.StatusInternalServerError)
+		return
+	}
+	a.recordBookmarkHistory(r.Context(), t.UID, profile.ID, b, nil)
+	apiresponse.OK200(w, nil)
+}
+
+// APITags lists the tags used on a profile and how many bookmarks have each tag
+// GET /api/v1/profiles/{profile}/tags
+func (a *App) APITags(w http.ResponseWriter, r *http.Request, t account.APIToken) {
+	_, bookmarks, ok := a.apiBookmarks(w, r, t)
+	if !ok {
+		return
+	}
+	counts := make(map[string]int)
+	for _, b := range bookmarks {
+		for _, tag := range b.Tags {
+			counts[tag]++
+		}
+	}
+	out := make([]TagCount, 0, len(counts))
+	for tag, n := range counts {
+		out = append(out, TagCount{Tag: tag, Count: n})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Tag < out[j].Tag })
+	apiresponse.OK200(w, out)
+}
+
+// APIScorecard returns the scorecard for a legislative body. ?tag= filters by tag
+// GET /api/v1/profiles/{profile}/scorecards/{body}
+func (a *App) APIScorecard(w http.ResponseWriter, r *http.Request, t account.APIToken) {
+	bodyID := legislature.BodyID(r.PathValue("body"))
+	body, ok := resolvers.Bodies[bodyID]
+	if !ok || !resolvers.IsValidBodyID(bodyID) {
+		apiresponse.Error(w, apiresponse.StatusText(404), http.StatusNotFound)
+		return
+	}
+	if body.Unavailable {
+		apiresponse.Error(w, body.Name+" is temporarily unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	profile, bookmarks, ok := a.apiBookmarks(w, r, t)
+	if !ok {
+		return
+	}
+	var out APIScorecard
+	var err error
+	out.Scorecard, out.WhipCounts, err = buildScorecard(r.Context(), bookmarks, body, r.URL.Query().Get("tag"), profile.ScorecardOptions)
+	if err != nil {
+		log.WithContext(r.Context()).WithFields(log.Fields{"uid": t.UID, "profileID": profile.ID, "body": bodyID}).Errorf("%#v", err)
+		apiresponse.Error(w, apiresponse.StatusText(500), http.StatusInternalServerError)
+		return
+	}
+	apiresponse.OK200(w, out)
+}
+
+// OpenAPI serves the OpenAPI description of /api/v1
+// GET /api/v1/openapi.json
+func (a *App) OpenAPI(w http.ResponseWriter, r *http.Request) {
+	b, err := static.ReadFile("static/openapi.json")
+	if err != nil {
+		log.WithContext(r.Context()).Errorf("%#v", err)
+		apiresponse.Error(w, apiresponse.StatusText(500), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Write(b)
+}
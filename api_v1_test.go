@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/jehiah/legislation.support/internal/account"
+)
+
+func TestAPIBookmarkEdit(t *testing.T) {
+	existing := account.Bookmark{
+		BodyID:        "nyc",
+		LegislationID: "1234-2026",
+		Position:      account.WatchPosition,
+		Notes:         "note",
+		Tags:          []string{"a", "b"},
+		Rank:          2,
+		Weight:        3,
+	}
+	type testCase struct {
+		body     string
+		expected func(b *account.Bookmark)
+	}
+	tests := []testCase{
+		// re-posting an existing bookmark with only the legislation
+		{`{"Legislation": "Int 1234-2026"}`, func(b *account.Bookmark) {}},
+		{`{"Notes": " new ", "Tags": ["c d"]}`, func(b *account.Bookmark) { b.Notes, b.Tags = "new", []string{"c", "d"} }},
+		{`{"Oppose": true}`, func(b *account.Bookmark) { b.SetPosition(account.OpposePosition) }},
+		{`{"Position": "neutral", "Oppose": true, "Rank": 0}`, func(b *account.Bookmark) { b.SetPosition(account.NeutralPosition); b.Rank = 0 }},
+	}
+	for i, tc := range tests {
+		var req APIBookmarkEdit
+		if err := json.Unmarshal([]byte(tc.body), &req); err != nil {
+			t.Fatal(err)
+		}
+		got := existing
+		req.edit().Apply(&got)
+		expected := existing
+		tc.expected(&expected)
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("[%d] got %#v expected %#v", i, got, expected)
+		}
+	}
+}
+
+func TestAPIBookmarkEditValidate(t *testing.T) {
+	type testCase struct {
+		body     string
+		expected error
+	}
+	tests := []testCase{
+		{`{"Weight": 100}`, nil},
+		{`{"Weight": 1000000}`, account.ErrInvalidWeight},
+		{`{"Weight": -1}`, account.ErrInvalidWeight},
+		{`{"Position": "against"}`, account.ErrInvalidPosition},
+	}
+	for i, tc := range tests {
+		var req APIBookmarkEdit
+		if err := json.Unmarshal([]byte(tc.body), &req); err != nil {
+			t.Fatal(err)
+		}
+		if got := req.validate(); got != tc.expected {
+			t.Errorf("[%d] got %v expected %v", i, got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/account"
+	"github.com/jehiah/legislation.support/internal/legislature"
+	"github.com/jehiah/legislation.support/internal/resolvers"
+	log "github.com/sirupsen/logrus"
+)
+
+// recordBookmarkHistory appends to the bookmark history when uid changes a bookmark; old is nil for a
+// new bookmark and new is nil when it's removed. The bookmark is already saved so errors are only logged
+func (a *App) recordBookmarkHistory(ctx context.Context, uid account.UID, profileID account.ProfileID, old, new *account.Bookmark) {
+	h, ok := account.NewBookmarkHistory(uid, old, new, time.Now().UTC())
+	if !ok {
+		return
+	}
+	if err := a.AddBookmarkHistory(ctx, profileID, h); err != nil {
+		log.WithContext(ctx).WithFields(log.Fields{"uid": uid, "profileID": profileID, "bookmark": h.Key()}).Errorf("%#v", err)
+	}
+}
+
+// ProfileBookmarkHistory shows the changes to a bookmark; it's limited to profile members
+// GET /{profile}/history/{body}/{legislation}
+func (a *App) ProfileBookmarkHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	profileID := account.ProfileID(r.PathValue("profile"))
+	if !account.IsValidProfileID(profileID) {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+	bodyID := legislature.BodyID(r.PathValue("body"))
+	body, ok := resolvers.Bodies[bodyID]
+	if !ok || !resolvers.IsValidBodyID(bodyID) {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+	legislationID := legislature.LegislationID(r.PathValue("legislation"))
+
+	uid := a.User(r)
+	fields := log.Fields{"uid": uid, "profileID": profileID, "body": bodyID, "legislation": legislationID}
+
+	profile, err := a.GetProfile(ctx, profileID)
+	if err != nil {
+		log.WithFields(fields).Errorf("%#v", err)
+		a.WebInternalError500(w, "")
+		return
+	}
+	if profile == nil {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+	role := profile.Role(uid)
+	if !role.CanView() {
+		a.WebPermissionError403(w, "")
+		return
+	}
+
+	history, err := a.GetBookmarkHistory(ctx, profileID, bodyID, legislationID)
+	if err != nil {
+		log.WithFields(fields).Errorf("%#v", err)
+		a.WebInternalError500(w, "")
+		return
+	}
+	bookmark, err := a.GetBookmark(ctx, profileID, account.BookmarkKey(bodyID, legislationID))
+	if err != nil {
+		log.WithFields(fields).Errorf("%#v", err)
+		a.WebInternalError500(w, "")
+		return
+	}
+	if bookmark == nil && len(history) == 0 {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+	var l *legislature.Legislation
+	if l, err = a.GetBill(ctx, bodyID, legislationID); err != nil {
+		log.WithFields(fields).Errorf("%#v", err)
+	}
+
+	type Page struct {
+		Page          string
+		Title         string
+		UID           account.UID
+		Profile       account.Profile
+		Role          account.Role
+		Body          legislature.Body
+		LegislationID legislature.LegislationID
+		Legislation   *legislature.Legislation
+		History       []account.BookmarkHistory
+	}
+	templateName := "profile_history.html"
+	t := newTemplate(a.templateFS, templateName)
+	err = t.ExecuteTemplate(w, templateName, Page{
+		Title:         profile.Name + " " + LegislationDisplayID(bodyID, legislationID) + " History",
+		UID:           uid,
+		Profile:       *profile,
+		Role:          role,
+		Body:          body,
+		LegislationID: legislationID,
+		Legislation:   l,
+		History:       history,
+	})
+	if err != nil {
+		log.WithFields(fields).Error(err)
+		a.WebInternalError500(w, "")
+	}
+}
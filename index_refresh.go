@@ -36,7 +36,8 @@ func (a *App) InternalRefresh(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	bills, err := a.GetStaleBills(ctx, limit)
+	// bills for unavailable bodies are excluded so they don't use up the limit
+	bills, err := a.GetStaleBills(ctx, limit, resolvers.AvailableBodies())
 	if err != nil {
 		log.Printf("err %s", err)
 		http.Error(w, err.Error(), 500)
@@ -60,6 +61,10 @@ func (a *App) InternalRefresh(w http.ResponseWriter, r *http.Request) {
 					return nil
 				default:
 				}
+				if !resolvers.IsAvailable(l.Body) {
+					atomic.AddInt64(&skipped, 1)
+					return nil
+				}
 				udpatedLeg, err := resolvers.Resolvers.Find(l.Body).Refresh(ctx, l.ID)
 				if err != nil {
 					return err
@@ -71,7 +76,7 @@ func (a *App) InternalRefresh(w http.ResponseWriter, r *http.Request) {
 					if err != nil {
 						return err
 					}
-					if staleSameAs {
+					if staleSameAs && resolvers.IsAvailable(resolvers.Bodies[l.Body].Bicameral) {
 						// refresh the sameAs bill (if needed)
 						sameAsBody := resolvers.Bodies[l.Body].Bicameral
 						sameBill, err := resolvers.Resolvers.Find(sameAsBody).Refresh(ctx, udpatedLeg.SameAs)
@@ -0,0 +1,91 @@
+package account
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Scope limits what an APIToken can do
+type Scope string
+
+const (
+	ProfilesReadScope   Scope = "profiles:read"   // list and read profiles, tags and scorecards
+	ProfilesWriteScope  Scope = "profiles:write"  // create, update and delete profiles
+	BookmarksReadScope  Scope = "bookmarks:read"  // list and read bookmarks
+	BookmarksWriteScope Scope = "bookmarks:write" // create, update and delete bookmarks
+)
+
+var Scopes = []Scope{ProfilesReadScope, ProfilesWriteScope, BookmarksReadScope, BookmarksWriteScope}
+
+func (s Scope) IsValid() bool { return slices.Contains(Scopes, s) }
+
+// APITokenPrefix identifies a personal API token (i.e. in a leaked credential scan)
+const APITokenPrefix = "ls_"
+
+// APIToken is a personal access token for /api/v1. It acts as UID limited to Scopes.
+//
+// Only a hash of the token is stored; the token is shown once when it's created
+type APIToken struct {
+	ID       string // public identifier
+	UID      UID
+	Name     string
+	Scopes   []Scope
+	Hash     string `json:"-"`
+	Created  time.Time
+	LastUsed time.Time `firestore:",omitempty" json:",omitempty"`
+}
+
+var (
+	ErrInvalidScope = errors.New("invalid scope")
+	ErrNoScopes     = errors.New("at least one scope is required")
+)
+
+// HashAPIToken returns the stored hash of a token
+func HashAPIToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// NewAPIToken creates a token for u; the returned secret is only available now
+func NewAPIToken(u UID, name string, scopes []Scope, now time.Time) (string, APIToken, error) {
+	if len(scopes) == 0 {
+		return "", APIToken{}, ErrNoScopes
+	}
+	for _, s := range scopes {
+		if !s.IsValid() {
+			return "", APIToken{}, ErrInvalidScope
+		}
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", APIToken{}, err
+	}
+	secret := APITokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	hash := HashAPIToken(secret)
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = "API Token"
+	}
+	t := APIToken{
+		ID:      hash[:12],
+		UID:     u,
+		Name:    name,
+		Scopes:  slices.Compact(slices.Sorted(slices.Values(scopes))),
+		Hash:    hash,
+		Created: now,
+	}
+	return secret, t, nil
+}
+
+// IsAPIToken returns if s looks like a personal API token
+func IsAPIToken(s string) bool {
+	return strings.HasPrefix(s, APITokenPrefix) && len(s) > len(APITokenPrefix)
+}
+
+func (t APIToken) HasScope(s Scope) bool { return slices.Contains(t.Scopes, s) }
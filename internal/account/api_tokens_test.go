@@ -0,0 +1,53 @@
+package account
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewAPIToken(t *testing.T) {
+	now := time.Now()
+	if _, _, err := NewAPIToken("u", "", nil, now); !errors.Is(err, ErrNoScopes) {
+		t.Errorf("got %v expected ErrNoScopes", err)
+	}
+	if _, _, err := NewAPIToken("u", "", []Scope{"admin"}, now); !errors.Is(err, ErrInvalidScope) {
+		t.Errorf("got %v expected ErrInvalidScope", err)
+	}
+
+	secret, token, err := NewAPIToken("u", " CI ", []Scope{BookmarksWriteScope, ProfilesReadScope, BookmarksWriteScope}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsAPIToken(secret) {
+		t.Errorf("IsAPIToken(%q) false", secret)
+	}
+	if token.Hash != HashAPIToken(secret) || token.Hash == secret {
+		t.Errorf("unexpected hash %q", token.Hash)
+	}
+	if token.Name != "CI" || token.UID != "u" || token.ID == "" {
+		t.Errorf("unexpected token %#v", token)
+	}
+	if len(token.Scopes) != 2 {
+		t.Errorf("expected duplicate scopes removed %v", token.Scopes)
+	}
+	type testCase struct {
+		scope Scope
+		ok    bool
+	}
+	for _, tc := range []testCase{
+		{ProfilesReadScope, true},
+		{BookmarksWriteScope, true},
+		{BookmarksReadScope, false},
+		{ProfilesWriteScope, false},
+	} {
+		if got := token.HasScope(tc.scope); got != tc.ok {
+			t.Errorf("HasScope(%q) got %v expected %v", tc.scope, got, tc.ok)
+		}
+	}
+
+	other, _, _ := NewAPIToken("u", "", []Scope{ProfilesReadScope}, now)
+	if other == secret {
+		t.Errorf("expected unique tokens")
+	}
+}
@@ -0,0 +1,56 @@
+package account
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// MaxWeight is the largest scorecard weight for a bookmark
+const MaxWeight = 100
+
+var ErrInvalidWeight = fmt.Errorf("weight must be from 0 to %d", MaxWeight)
+
+// ValidWeight is true for a weight from 0 (the default weight) to MaxWeight
+func ValidWeight(w int) bool { return w >= 0 && w <= MaxWeight }
+
+// BookmarkEdit is the user editable part of a Bookmark; nil fields are unchanged
+type BookmarkEdit struct {
+	Position *Position
+	Notes    *string
+	Tags     *[]string
+	Rank     *int // 0 is unranked
+	Weight   *int // 0 is the default weight
+
+	Created time.Time // for a new bookmark; zero is now
+}
+
+// Validate returns ErrInvalidPosition or ErrInvalidWeight
+func (e BookmarkEdit) Validate() error {
+	if e.Position != nil && !e.Position.IsValid() {
+		return ErrInvalidPosition
+	}
+	if e.Weight != nil && !ValidWeight(*e.Weight) {
+		return ErrInvalidWeight
+	}
+	return nil
+}
+
+// Apply sets the fields of b that are set in e
+func (e BookmarkEdit) Apply(b *Bookmark) {
+	if e.Position != nil {
+		b.SetPosition(*e.Position)
+	}
+	if e.Notes != nil {
+		b.Notes = *e.Notes
+	}
+	if e.Tags != nil {
+		b.Tags = slices.Clone(*e.Tags)
+	}
+	if e.Rank != nil {
+		b.Rank = max(*e.Rank, 0)
+	}
+	if e.Weight != nil {
+		b.Weight = min(max(*e.Weight, 0), MaxWeight)
+	}
+}
@@ -0,0 +1,65 @@
+package account
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBookmarkEditApply(t *testing.T) {
+	existing := Bookmark{
+		BodyID:        "nyc",
+		LegislationID: "1234-2026",
+		Position:      SupportIfAmendedPosition,
+		Notes:         "note",
+		Tags:          []string{"a", "b"},
+		Rank:          2,
+		Weight:        3,
+	}
+	oppose := OpposePosition
+	notes := ""
+	tags := []string{"c"}
+	zero := 0
+
+	type testCase struct {
+		edit     BookmarkEdit
+		expected Bookmark
+	}
+	tests := []testCase{
+		// i.e. re-adding an existing bookmark with only the legislation set
+		{BookmarkEdit{}, existing},
+		{BookmarkEdit{Position: &oppose}, Bookmark{BodyID: "nyc", LegislationID: "1234-2026", Position: OpposePosition, Oppose: true, Notes: "note", Tags: []string{"a", "b"}, Rank: 2, Weight: 3}},
+		{BookmarkEdit{Notes: &notes, Tags: &tags, Rank: &zero}, Bookmark{BodyID: "nyc", LegislationID: "1234-2026", Position: SupportIfAmendedPosition, Tags: []string{"c"}, Weight: 3}},
+	}
+	for i, tc := range tests {
+		b := existing
+		b.Tags = append([]string(nil), existing.Tags...)
+		tc.edit.Apply(&b)
+		if !reflect.DeepEqual(b, tc.expected) {
+			t.Errorf("[%d] got %#v expected %#v", i, b, tc.expected)
+		}
+	}
+}
+
+func TestBookmarkEditValidate(t *testing.T) {
+	invalid := Position("against")
+	type testCase struct {
+		edit     BookmarkEdit
+		expected error
+	}
+	tests := []testCase{
+		{BookmarkEdit{}, nil},
+		{BookmarkEdit{Weight: ptr(0)}, nil},
+		{BookmarkEdit{Weight: ptr(MaxWeight)}, nil},
+		{BookmarkEdit{Weight: ptr(MaxWeight + 1)}, ErrInvalidWeight},
+		{BookmarkEdit{Weight: ptr(1000000)}, ErrInvalidWeight},
+		{BookmarkEdit{Weight: ptr(-1)}, ErrInvalidWeight},
+		{BookmarkEdit{Position: &invalid}, ErrInvalidPosition},
+	}
+	for i, tc := range tests {
+		if got := tc.edit.Validate(); got != tc.expected {
+			t.Errorf("[%d] got %v expected %v", i, got, tc.expected)
+		}
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
@@ -0,0 +1,138 @@
+package account
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+// HistoryAction is the kind of change recorded in a BookmarkHistory
+type HistoryAction string
+
+const (
+	BookmarkCreated HistoryAction = "created"
+	BookmarkUpdated HistoryAction = "updated"
+	BookmarkDeleted HistoryAction = "deleted"
+)
+
+// BookmarkValues are the user edited fields of a Bookmark
+type BookmarkValues struct {
+	Position Position
+	Oppose   bool
+	Tags     []string `firestore:",omitempty" json:",omitempty"`
+	Notes    string   `firestore:",omitempty" json:",omitempty"`
+	Rank     int      `firestore:",omitempty" json:",omitempty"`
+	Weight   int      `firestore:",omitempty" json:",omitempty"`
+}
+
+func (b Bookmark) Values() BookmarkValues {
+	return BookmarkValues{
+		Position: b.GetPosition(),
+		Oppose:   b.Oppose,
+		Tags:     slices.Clone(b.Tags),
+		Notes:    b.Notes,
+		Rank:     b.Rank,
+		Weight:   b.Weight,
+	}
+}
+
+// BookmarkHistory is an entry in the append-only history of changes to a bookmark
+type BookmarkHistory struct {
+	BodyID        legislature.BodyID
+	LegislationID legislature.LegislationID
+	UID           UID // the user that made the change
+	Created       time.Time
+	Action        HistoryAction
+	Old           *BookmarkValues `firestore:",omitempty" json:",omitempty"` // nil when created
+	New           *BookmarkValues `firestore:",omitempty" json:",omitempty"` // nil when deleted
+}
+
+// NewBookmarkHistory records the change from old to new; old is nil for a new bookmark and new is nil for
+// a deleted bookmark. It returns false when the user edited fields are unchanged
+func NewBookmarkHistory(u UID, old, new *Bookmark, now time.Time) (BookmarkHistory, bool) {
+	h := BookmarkHistory{UID: u, Created: now}
+	switch {
+	case old == nil && new == nil:
+		return h, false
+	case old == nil:
+		h.Action = BookmarkCreated
+	case new == nil:
+		h.Action = BookmarkDeleted
+	default:
+		h.Action = BookmarkUpdated
+	}
+	for _, b := range []*Bookmark{new, old} {
+		if b == nil {
+			continue
+		}
+		h.BodyID, h.LegislationID = b.BodyID, b.LegislationID
+	}
+	if old != nil {
+		v := old.Values()
+		h.Old = &v
+	}
+	if new != nil {
+		v := new.Values()
+		h.New = &v
+	}
+	if h.Action == BookmarkUpdated && len(h.Changes()) == 0 {
+		return h, false
+	}
+	return h, true
+}
+
+func (h BookmarkHistory) Key() string {
+	return BookmarkKey(h.BodyID, h.LegislationID)
+}
+
+// FieldChange is a field that differs between BookmarkHistory.Old and New
+type FieldChange struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// Changes lists the fields that changed. For a created or deleted bookmark it's the fields that were set
+func (h BookmarkHistory) Changes() []FieldChange {
+	var old, new BookmarkValues
+	if h.Old != nil {
+		old = *h.Old
+	}
+	if h.New != nil {
+		new = *h.New
+	}
+	number := func(n int) string {
+		if n == 0 {
+			return ""
+		}
+		return fmt.Sprint(n)
+	}
+	var out []FieldChange
+	for _, f := range []FieldChange{
+		{"Position", old.Position.Label(), new.Position.Label()},
+		{"Tags", strings.Join(old.Tags, " "), strings.Join(new.Tags, " ")},
+		{"Notes", old.Notes, new.Notes},
+		{"Priority", number(old.Rank), number(new.Rank)},
+		{"Weight", number(old.Weight), number(new.Weight)},
+	} {
+		if f.Old != f.New {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// PositionChanged is true when an update flips the position (i.e. from support to oppose)
+func (h BookmarkHistory) PositionChanged() bool {
+	return h.Old != nil && h.New != nil && h.Old.Position != h.New.Position
+}
+
+// SortedBookmarkHistory orders history newest first
+type SortedBookmarkHistory []BookmarkHistory
+
+func (s SortedBookmarkHistory) Len() int           { return len(s) }
+func (s SortedBookmarkHistory) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s SortedBookmarkHistory) Less(i, j int) bool { return s[i].Created.After(s[j].Created) }
@@ -0,0 +1,58 @@
+package account
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewBookmarkHistory(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	b := &Bookmark{BodyID: "nyc", LegislationID: "1234-2026", Tags: []string{"a"}, Notes: "note"}
+	opposed := &Bookmark{BodyID: "nyc", LegislationID: "1234-2026", Tags: []string{"a", "b"}, Notes: "note"}
+	opposed.SetPosition(OpposePosition)
+
+	type testCase struct {
+		old, new        *Bookmark
+		ok              bool
+		action          HistoryAction
+		changes         []string
+		positionChanged bool
+	}
+	tests := []testCase{
+		{nil, nil, false, "", nil, false},
+		{b, b, false, BookmarkUpdated, nil, false},
+		{nil, b, true, BookmarkCreated, []string{"Position", "Tags", "Notes"}, false},
+		{b, nil, true, BookmarkDeleted, []string{"Position", "Tags", "Notes"}, false},
+		{b, opposed, true, BookmarkUpdated, []string{"Position", "Tags"}, true},
+		{&Bookmark{Oppose: true}, &Bookmark{Position: OpposePosition, Oppose: true}, false, BookmarkUpdated, nil, false},
+	}
+	for i, tc := range tests {
+		h, ok := NewBookmarkHistory("uid", tc.old, tc.new, now)
+		if ok != tc.ok {
+			t.Errorf("[%d] got ok %v expected %v", i, ok, tc.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if h.Action != tc.action || h.Key() != "nyc.1234-2026" || !h.Created.Equal(now) {
+			t.Errorf("[%d] got %#v", i, h)
+		}
+		var fields []string
+		for _, c := range h.Changes() {
+			fields = append(fields, c.Field)
+		}
+		if len(fields) != len(tc.changes) {
+			t.Errorf("[%d] got changes %v expected %v", i, fields, tc.changes)
+		} else {
+			for j := range fields {
+				if fields[j] != tc.changes[j] {
+					t.Errorf("[%d] got changes %v expected %v", i, fields, tc.changes)
+				}
+			}
+		}
+		if h.PositionChanged() != tc.positionChanged {
+			t.Errorf("[%d] got PositionChanged %v", i, h.PositionChanged())
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package account
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+// ExportVersion is the ProfileExport format version
+const ExportVersion = 1
+
+// ProfileExport is a full download of a profile's bookmarks (and their history) that can be imported again
+type ProfileExport struct {
+	Version   int
+	Exported  time.Time
+	Profile   ExportedProfile
+	Bookmarks []ExportedBookmark
+	History   []BookmarkHistory `json:",omitempty"`
+}
+
+// ExportedProfile identifies the profile an export was made from; members and share keys are not exported
+type ExportedProfile struct {
+	ID          ProfileID
+	Name        string
+	Description string `json:",omitempty"`
+}
+
+// ExportedBookmark is a bookmark in a ProfileExport; on import the legislation is looked up again from URL
+type ExportedBookmark struct {
+	BodyID        legislature.BodyID
+	LegislationID legislature.LegislationID
+	DisplayID     string
+	Title         string
+	URL           string // a legislation URL or citation
+	Position      Position
+	Tags          []string `json:",omitempty"`
+	Notes         string   `json:",omitempty"`
+	Rank          int      `json:",omitempty"`
+	Weight        int      `json:",omitempty"`
+	Created       time.Time
+	LastModified  time.Time
+}
+
+func NewProfileExport(p Profile, b Bookmarks, h []BookmarkHistory, now time.Time) ProfileExport {
+	e := ProfileExport{
+		Version:   ExportVersion,
+		Exported:  now,
+		Profile:   ExportedProfile{ID: p.ID, Name: p.Name, Description: p.Description},
+		Bookmarks: make([]ExportedBookmark, 0, len(b)),
+		History:   h,
+	}
+	for _, bb := range b {
+		e.Bookmarks = append(e.Bookmarks, NewExportedBookmark(bb))
+	}
+	return e
+}
+
+func NewExportedBookmark(b Bookmark) ExportedBookmark {
+	e := ExportedBookmark{
+		BodyID:        b.BodyID,
+		LegislationID: b.LegislationID,
+		Position:      b.GetPosition(),
+		Tags:          slices.Clone(b.Tags),
+		Notes:         b.Notes,
+		Rank:          b.Rank,
+		Weight:        b.Weight,
+		Created:       b.Created,
+		LastModified:  b.LastModified,
+	}
+	if b.Legislation != nil {
+		e.DisplayID = b.Legislation.DisplayID
+		e.Title = b.Legislation.Title
+		e.URL = b.Legislation.URL
+	}
+	return e
+}
+
+// Input is the URL (or citation) to look up when importing
+func (e ExportedBookmark) Input() string {
+	if e.URL != "" {
+		return e.URL
+	}
+	return e.DisplayID
+}
+
+// ExportCSVHeader is the first row of a CSV export. History is only included in the JSON export
+var ExportCSVHeader = []string{"body", "legislation_id", "display_id", "title", "url", "position", "tags", "notes", "priority", "weight", "created", "last_modified"}
+
+// WriteExportCSV writes bookmarks as CSV with ExportCSVHeader; tags are space separated
+func WriteExportCSV(w io.Writer, bookmarks []ExportedBookmark) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(ExportCSVHeader); err != nil {
+		return err
+	}
+	number := func(n int) string {
+		if n == 0 {
+			return ""
+		}
+		return strconv.Itoa(n)
+	}
+	date := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	for _, b := range bookmarks {
+		err := cw.Write([]string{
+			string(b.BodyID),
+			string(b.LegislationID),
+			b.DisplayID,
+			b.Title,
+			b.URL,
+			string(b.Position),
+			strings.Join(b.Tags, " "),
+			b.Notes,
+			number(b.Rank),
+			number(b.Weight),
+			date(b.Created),
+			date(b.LastModified),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadExport reads bookmarks from a ProfileExport in JSON or a CSV export. A CSV only needs a "url" (or
+// "display_id") column; a blank position is support
+func ReadExport(r io.Reader) ([]ExportedBookmark, error) {
+	br := bufio.NewReader(r)
+	start, _ := br.Peek(512)
+	var out []ExportedBookmark
+	if strings.HasPrefix(string(bytes.TrimSpace(start)), "{") {
+		var e ProfileExport
+		if err := json.NewDecoder(br).Decode(&e); err != nil {
+			return nil, fmt.Errorf("invalid JSON export %w", err)
+		}
+		out = e.Bookmarks
+	} else {
+		var err error
+		if out, err = readExportCSV(br); err != nil {
+			return nil, err
+		}
+	}
+	for i := range out {
+		if out[i].Position == "" {
+			out[i].Position = SupportPosition
+		}
+		if !out[i].Position.IsValid() {
+			return nil, fmt.Errorf("bookmark %d %w %q", i+1, ErrInvalidPosition, out[i].Position)
+		}
+		if !ValidWeight(out[i].Weight) {
+			return nil, fmt.Errorf("bookmark %d %w", i+1, ErrInvalidWeight)
+		}
+		if strings.TrimSpace(out[i].Input()) == "" {
+			return nil, fmt.Errorf("bookmark %d missing url", i+1)
+		}
+	}
+	return out, nil
+}
+
+func readExportCSV(r io.Reader) ([]ExportedBookmark, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("empty CSV")
+	}
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int)
+	for i, c := range header {
+		columns[strings.ToLower(strings.TrimSpace(c))] = i
+	}
+	if _, ok := columns["url"]; !ok {
+		if _, ok := columns["display_id"]; !ok {
+			return nil, fmt.Errorf("CSV header is missing a url column")
+		}
+	}
+
+	var out []ExportedBookmark
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		get := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		b := ExportedBookmark{
+			BodyID:        legislature.BodyID(get("body")),
+			LegislationID: legislature.LegislationID(get("legislation_id")),
+			DisplayID:     get("display_id"),
+			Title:         get("title"),
+			URL:           get("url"),
+			Position:      Position(get("position")),
+			Notes:         get("notes"),
+		}
+		if b.Input() == "" {
+			continue
+		}
+		if tags := strings.Fields(get("tags")); len(tags) > 0 {
+			b.Tags = tags
+		}
+		for name, n := range map[string]*int{"priority": &b.Rank, "weight": &b.Weight} {
+			if v := get(name); v != "" {
+				if *n, err = strconv.Atoi(v); err != nil || *n < 0 {
+					return nil, fmt.Errorf("line %d invalid %s %q", line, name, v)
+				}
+			}
+		}
+		for name, t := range map[string]*time.Time{"created": &b.Created, "last_modified": &b.LastModified} {
+			if v := get(name); v != "" {
+				if *t, err = time.Parse(time.RFC3339, v); err != nil {
+					return nil, fmt.Errorf("line %d invalid %s %q", line, name, v)
+				}
+			}
+		}
+		out = append(out, b)
+	}
+	return out, nil
+}
@@ -0,0 +1,93 @@
+package account
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+func TestExportRoundTrip(t *testing.T) {
+	created := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	bookmarks := Bookmarks{
+		{
+			BodyID:        "nyc",
+			LegislationID: "1234-2026",
+			Tags:          []string{"housing", "zoning"},
+			Notes:         "call, then \"email\"",
+			Rank:          1,
+			Weight:        2,
+			Created:       created,
+			LastModified:  created.Add(time.Hour),
+			Legislation:   &legislature.Legislation{DisplayID: "Int 1234-2026", Title: "A Local Law", URL: "https://legistar.council.nyc.gov/x"},
+		},
+		{
+			BodyID:        "ny-senate",
+			LegislationID: "2025-S1234",
+			Oppose:        true,
+			Created:       created,
+			Legislation:   &legislature.Legislation{DisplayID: "S1234", URL: "https://www.nysenate.gov/legislation/bills/2025/S1234"},
+		},
+		{BodyID: "us-house", LegislationID: "119-hr-5", Position: WatchPosition, Legislation: &legislature.Legislation{DisplayID: "H.R. 5"}},
+	}
+	e := NewProfileExport(Profile{ID: "abc-def", Name: "Test", UID: "u"}, bookmarks, nil, created)
+	if e.Bookmarks[1].Position != OpposePosition {
+		t.Errorf("got position %q", e.Bookmarks[1].Position)
+	}
+
+	var jsonExport, csvExport bytes.Buffer
+	if err := json.NewEncoder(&jsonExport).Encode(e); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteExportCSV(&csvExport, e.Bookmarks); err != nil {
+		t.Fatal(err)
+	}
+	for name, b := range map[string]*bytes.Buffer{"json": &jsonExport, "csv": &csvExport} {
+		got, err := ReadExport(b)
+		if err != nil {
+			t.Fatalf("%s %s", name, err)
+		}
+		if !reflect.DeepEqual(got, e.Bookmarks) {
+			t.Errorf("%s got %#v\nexpected %#v", name, got, e.Bookmarks)
+		}
+	}
+	if got := e.Bookmarks[2].Input(); got != "H.R. 5" {
+		t.Errorf("Input got %q", got)
+	}
+}
+
+func TestReadExportCSV(t *testing.T) {
+	type testCase struct {
+		input     string
+		positions []Position
+		err       bool
+	}
+	tests := []testCase{
+		{"url\nS1234\n\nhttps://example.com/a\n", []Position{SupportPosition, SupportPosition}, false},
+		{"URL,Position,Tags\nS1234,oppose,a b\n", []Position{OpposePosition}, false},
+		{"url,position\nS1234,against\n", nil, true},
+		{"url,priority\nS1234,first\n", nil, true},
+		{"url,weight\nS1234,100\n", []Position{SupportPosition}, false},
+		{"url,weight\nS1234,1000000\n", nil, true},
+		{"notes\nx\n", nil, true},
+		{"", nil, true},
+	}
+	for i, tc := range tests {
+		got, err := ReadExport(strings.NewReader(tc.input))
+		if (err != nil) != tc.err {
+			t.Errorf("[%d] got err %v", i, err)
+			continue
+		}
+		var positions []Position
+		for _, b := range got {
+			positions = append(positions, b.Position)
+		}
+		if !reflect.DeepEqual(positions, tc.positions) {
+			t.Errorf("[%d] got %v expected %v", i, positions, tc.positions)
+		}
+	}
+}
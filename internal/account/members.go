@@ -0,0 +1,198 @@
+package account
+
+import (
+	"errors"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Role is a profile member's level of access
+type Role string
+
+const (
+	OwnerRole  Role = "owner"  // edit bookmarks, profile settings and members
+	EditorRole Role = "editor" // edit bookmarks
+	ViewerRole Role = "viewer" // read only
+)
+
+var Roles = []Role{OwnerRole, EditorRole, ViewerRole}
+
+func (r Role) IsValid() bool   { return slices.Contains(Roles, r) }
+func (r Role) CanView() bool   { return r.IsValid() }
+func (r Role) CanEdit() bool   { return r == OwnerRole || r == EditorRole }
+func (r Role) CanManage() bool { return r == OwnerRole }
+
+// ProfileMember is a user with access to a profile
+type ProfileMember struct {
+	UID   UID
+	Email string `firestore:",omitempty" json:",omitempty"`
+	Role  Role
+	Added time.Time
+}
+
+// Invitation is a pending ProfileMember; it's accepted by a user signed in with Email
+type Invitation struct {
+	Email     string
+	Role      Role
+	InvitedBy UID `json:"-"`
+	Created   time.Time
+}
+
+// InvitationExpiration is how long an invitation can be accepted
+const InvitationExpiration = time.Hour * 24 * 30
+
+func (i Invitation) Expired(now time.Time) bool {
+	return now.Sub(i.Created) > InvitationExpiration
+}
+
+var (
+	ErrInvalidEmail      = errors.New("invalid email address")
+	ErrInvalidRole       = errors.New("invalid role")
+	ErrAlreadyMember     = errors.New("already a member")
+	ErrNotMember         = errors.New("not a member")
+	ErrLastOwner         = errors.New("a profile must have an owner")
+	ErrInvitationInvalid = errors.New("invitation not found or expired")
+)
+
+// NormalizeEmail lowercases a valid email address
+func NormalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	a, err := mail.ParseAddress(email)
+	if err != nil || a.Address != email {
+		return "", ErrInvalidEmail
+	}
+	return email, nil
+}
+
+// Role returns the role of u. The user that created the profile is always an owner
+func (p Profile) Role(u UID) Role {
+	if u == "" {
+		return ""
+	}
+	if p.UID == u {
+		return OwnerRole
+	}
+	for _, m := range p.Members {
+		if m.UID == u {
+			return m.Role
+		}
+	}
+	return ""
+}
+
+// MemberName is the email (or ID) of member u, "Profile creator" or "Former member"
+func (p Profile) MemberName(u UID) string {
+	if p.UID == u {
+		return "Profile creator"
+	}
+	if i := p.member(u); i != -1 {
+		if p.Members[i].Email != "" {
+			return p.Members[i].Email
+		}
+		return string(u)
+	}
+	return "Former member"
+}
+
+func (p Profile) member(u UID) int {
+	return slices.IndexFunc(p.Members, func(m ProfileMember) bool { return m.UID == u })
+}
+
+// syncMembers updates the denormalized MemberUIDs and InvitedEmails used for queries
+func (p *Profile) syncMembers() {
+	p.MemberUIDs = nil
+	for _, m := range p.Members {
+		p.MemberUIDs = append(p.MemberUIDs, m.UID)
+	}
+	p.InvitedEmails = nil
+	for _, i := range p.Invitations {
+		p.InvitedEmails = append(p.InvitedEmails, i.Email)
+	}
+}
+
+// Invite adds (or replaces) a pending invitation for email
+func (p *Profile) Invite(email string, r Role, by UID, now time.Time) (Invitation, error) {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return Invitation{}, err
+	}
+	if !r.IsValid() {
+		return Invitation{}, ErrInvalidRole
+	}
+	if slices.ContainsFunc(p.Members, func(m ProfileMember) bool { return m.Email == email }) {
+		return Invitation{}, ErrAlreadyMember
+	}
+	i := Invitation{Email: email, Role: r, InvitedBy: by, Created: now}
+	p.Invitations = slices.DeleteFunc(p.Invitations, func(ii Invitation) bool { return ii.Email == email })
+	p.Invitations = append(p.Invitations, i)
+	p.syncMembers()
+	return i, nil
+}
+
+// Invitation returns the unexpired invitation for email
+func (p Profile) Invitation(email string, now time.Time) (Invitation, bool) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	for _, i := range p.Invitations {
+		if email != "" && i.Email == email && !i.Expired(now) {
+			return i, true
+		}
+	}
+	return Invitation{}, false
+}
+
+// RevokeInvitation removes the invitation for email
+func (p *Profile) RevokeInvitation(email string) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	p.Invitations = slices.DeleteFunc(p.Invitations, func(i Invitation) bool { return i.Email == email })
+	p.syncMembers()
+}
+
+// AcceptInvitation adds u as a member with the invited role
+func (p *Profile) AcceptInvitation(email string, u UID, now time.Time) error {
+	i, ok := p.Invitation(email, now)
+	if !ok || u == "" {
+		return ErrInvitationInvalid
+	}
+	p.RevokeInvitation(i.Email)
+	if p.Role(u) != "" {
+		return ErrAlreadyMember
+	}
+	p.Members = append(p.Members, ProfileMember{UID: u, Email: i.Email, Role: i.Role, Added: now})
+	p.syncMembers()
+	return nil
+}
+
+// SetRole changes the role of a member. The user that created the profile is always an owner
+func (p *Profile) SetRole(u UID, r Role) error {
+	if !r.IsValid() {
+		return ErrInvalidRole
+	}
+	if u == p.UID {
+		if r != OwnerRole {
+			return ErrLastOwner
+		}
+		return nil
+	}
+	idx := p.member(u)
+	if idx == -1 {
+		return ErrNotMember
+	}
+	p.Members[idx].Role = r
+	return nil
+}
+
+// RemoveMember removes a member. The user that created the profile can't be removed
+func (p *Profile) RemoveMember(u UID) error {
+	if u == p.UID {
+		return ErrLastOwner
+	}
+	idx := p.member(u)
+	if idx == -1 {
+		return ErrNotMember
+	}
+	p.Members = slices.Delete(p.Members, idx, idx+1)
+	p.syncMembers()
+	return nil
+}
@@ -0,0 +1,98 @@
+package account
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestProfileRole(t *testing.T) {
+	p := Profile{
+		UID: "owner",
+		Members: []ProfileMember{
+			{UID: "editor", Role: EditorRole},
+			{UID: "viewer", Role: ViewerRole},
+		},
+	}
+	type testCase struct {
+		uid    UID
+		role   Role
+		edit   bool
+		manage bool
+	}
+	tests := []testCase{
+		{"owner", OwnerRole, true, true},
+		{"editor", EditorRole, true, false},
+		{"viewer", ViewerRole, false, false},
+		{"other", "", false, false},
+		{"", "", false, false},
+	}
+	for _, tc := range tests {
+		t.Run(string(tc.uid), func(t *testing.T) {
+			r := p.Role(tc.uid)
+			if r != tc.role {
+				t.Errorf("Role got %q expected %q", r, tc.role)
+			}
+			if r.CanEdit() != tc.edit || p.HasAccess(tc.uid) != tc.edit {
+				t.Errorf("CanEdit got %v expected %v", r.CanEdit(), tc.edit)
+			}
+			if r.CanManage() != tc.manage {
+				t.Errorf("CanManage got %v expected %v", r.CanManage(), tc.manage)
+			}
+		})
+	}
+}
+
+func TestProfileInvitation(t *testing.T) {
+	now := time.Now()
+	p := Profile{UID: "owner"}
+
+	if _, err := p.Invite("not an email", EditorRole, "owner", now); !errors.Is(err, ErrInvalidEmail) {
+		t.Errorf("got %v expected ErrInvalidEmail", err)
+	}
+	if _, err := p.Invite("a@example.com", "admin", "owner", now); !errors.Is(err, ErrInvalidRole) {
+		t.Errorf("got %v expected ErrInvalidRole", err)
+	}
+	if _, err := p.Invite(" A@Example.com ", ViewerRole, "owner", now); err != nil {
+		t.Fatal(err)
+	}
+	// a second invite replaces the first
+	if _, err := p.Invite("a@example.com", EditorRole, "owner", now); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Invitations) != 1 || len(p.InvitedEmails) != 1 || p.InvitedEmails[0] != "a@example.com" {
+		t.Fatalf("unexpected invitations %#v %v", p.Invitations, p.InvitedEmails)
+	}
+
+	if err := p.AcceptInvitation("b@example.com", "b", now); !errors.Is(err, ErrInvitationInvalid) {
+		t.Errorf("got %v expected ErrInvitationInvalid", err)
+	}
+	if err := p.AcceptInvitation("a@example.com", "a", now.Add(InvitationExpiration+time.Hour)); !errors.Is(err, ErrInvitationInvalid) {
+		t.Errorf("got %v expected ErrInvitationInvalid for expired invitation", err)
+	}
+	if err := p.AcceptInvitation("a@example.com", "a", now); err != nil {
+		t.Fatal(err)
+	}
+	if p.Role("a") != EditorRole || len(p.Invitations) != 0 || len(p.MemberUIDs) != 1 {
+		t.Fatalf("unexpected profile %#v", p)
+	}
+	if _, err := p.Invite("a@example.com", EditorRole, "owner", now); !errors.Is(err, ErrAlreadyMember) {
+		t.Errorf("got %v expected ErrAlreadyMember", err)
+	}
+
+	if err := p.SetRole("a", ViewerRole); err != nil || p.Role("a") != ViewerRole {
+		t.Errorf("SetRole got %v %q", err, p.Role("a"))
+	}
+	if err := p.SetRole("owner", EditorRole); !errors.Is(err, ErrLastOwner) {
+		t.Errorf("got %v expected ErrLastOwner", err)
+	}
+	if err := p.RemoveMember("owner"); !errors.Is(err, ErrLastOwner) {
+		t.Errorf("got %v expected ErrLastOwner", err)
+	}
+	if err := p.RemoveMember("a"); err != nil || p.Role("a") != "" || len(p.MemberUIDs) != 0 {
+		t.Errorf("RemoveMember got %v %#v", err, p)
+	}
+	if err := p.RemoveMember("a"); !errors.Is(err, ErrNotMember) {
+		t.Errorf("got %v expected ErrNotMember", err)
+	}
+}
@@ -0,0 +1,85 @@
+package account
+
+import (
+	"errors"
+	"slices"
+)
+
+// Position is a profile's position on a bookmarked bill
+type Position string
+
+const (
+	SupportPosition          Position = "support"
+	OpposePosition           Position = "oppose"
+	SupportIfAmendedPosition Position = "support-if-amended"
+	NeutralPosition          Position = "neutral"
+	WatchPosition            Position = "watch" // tracked without a position
+)
+
+var Positions = []Position{SupportPosition, SupportIfAmendedPosition, OpposePosition, NeutralPosition, WatchPosition}
+
+var ErrInvalidPosition = errors.New("invalid position")
+
+func (p Position) IsValid() bool { return slices.Contains(Positions, p) }
+
+// IsSupport is true for SupportPosition and SupportIfAmendedPosition
+func (p Position) IsSupport() bool { return p == SupportPosition || p == SupportIfAmendedPosition }
+
+// IsScored is true for positions that are counted on a scorecard
+func (p Position) IsScored() bool { return p.IsSupport() || p == OpposePosition }
+
+// Label is the display name i.e. "Support If Amended"
+func (p Position) Label() string {
+	switch p {
+	case SupportPosition:
+		return "Support"
+	case OpposePosition:
+		return "Oppose"
+	case SupportIfAmendedPosition:
+		return "Support If Amended"
+	case NeutralPosition:
+		return "Neutral"
+	case WatchPosition:
+		return "Watching"
+	}
+	return string(p)
+}
+
+// GetPosition returns the Position; bookmarks saved before Position existed only have Oppose
+func (b Bookmark) GetPosition() Position {
+	if b.Position == "" {
+		if b.Oppose {
+			return OpposePosition
+		}
+		return SupportPosition
+	}
+	return b.Position
+}
+
+// SetPosition sets Position and keeps Oppose in sync
+func (b *Bookmark) SetPosition(p Position) {
+	b.Position = p
+	b.Oppose = p == OpposePosition
+}
+
+// CountPosition counts bookmarks with any of the positions
+func (b Bookmarks) CountPosition(p ...Position) int {
+	var n int
+	for _, bb := range b {
+		if slices.Contains(p, bb.GetPosition()) {
+			n++
+		}
+	}
+	return n
+}
+
+// Scored excludes bookmarks that are watched or neutral
+func (b Bookmarks) Scored() Bookmarks {
+	var out Bookmarks
+	for _, bb := range b {
+		if bb.GetPosition().IsScored() {
+			out = append(out, bb)
+		}
+	}
+	return out
+}
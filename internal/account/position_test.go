@@ -0,0 +1,61 @@
+package account
+
+import (
+	"testing"
+)
+
+func TestBookmarkPosition(t *testing.T) {
+	type testCase struct {
+		b        Bookmark
+		expected Position
+		tag      string
+	}
+	tests := []testCase{
+		{Bookmark{}, SupportPosition, ""},
+		{Bookmark{Oppose: true}, OpposePosition, "Oppose"},
+		{Bookmark{Position: WatchPosition}, WatchPosition, "Watching"},
+		{Bookmark{Position: SupportIfAmendedPosition}, SupportIfAmendedPosition, "Support If Amended"},
+	}
+	for _, tc := range tests {
+		if got := tc.b.GetPosition(); got != tc.expected {
+			t.Errorf("%#v got %q expected %q", tc.b, got, tc.expected)
+		}
+		var tag string
+		if tags := tc.b.DisplayTags(); len(tags) > 0 {
+			tag = tags[0].Tag
+		}
+		if tag != tc.tag {
+			t.Errorf("%#v got tag %q expected %q", tc.b, tag, tc.tag)
+		}
+	}
+
+	var b Bookmark
+	b.SetPosition(OpposePosition)
+	if !b.Oppose {
+		t.Errorf("expected Oppose")
+	}
+	b.SetPosition(NeutralPosition)
+	if b.Oppose || b.NewScore().Oppose {
+		t.Errorf("expected Oppose to be cleared")
+	}
+
+	bookmarks := Bookmarks{
+		{},
+		{Oppose: true},
+		{Position: SupportIfAmendedPosition},
+		{Position: NeutralPosition},
+		{Position: WatchPosition},
+	}
+	if n := bookmarks.CountSupported(); n != 2 {
+		t.Errorf("CountSupported got %d", n)
+	}
+	if n := bookmarks.CountOpposed(); n != 1 {
+		t.Errorf("CountOpposed got %d", n)
+	}
+	if n := bookmarks.CountWatched(); n != 2 {
+		t.Errorf("CountWatched got %d", n)
+	}
+	if n := len(bookmarks.Scored()); n != 3 {
+		t.Errorf("Scored got %d", n)
+	}
+}
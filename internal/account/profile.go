@@ -2,6 +2,7 @@ package account
 
 import (
 	"net/url"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -18,7 +19,16 @@ type Profile struct {
 	Description string
 	ID          ProfileID
 	Private     bool
-	UID         UID
+	UID         UID // the user that created the profile (an owner)
+
+	// Members (other than UID) and pending Invitations; see Role
+	Members       []ProfileMember `firestore:",omitempty" json:",omitempty"`
+	Invitations   []Invitation    `firestore:",omitempty" json:"-"`
+	MemberUIDs    []UID           `firestore:",omitempty" json:"-"` // for queries
+	InvitedEmails []string        `firestore:",omitempty" json:"-"` // for queries
+
+	// ShareKeys grant read access to a Private profile
+	ShareKeys []ShareKey `firestore:",omitempty" json:"-"`
 
 	Created      time.Time
 	LastModified time.Time
@@ -35,8 +45,9 @@ type ProfileRedirect struct {
 	Created time.Time
 }
 
+// HasAccess returns if u can edit the profile's bookmarks
 func (p Profile) HasAccess(u UID) bool {
-	return p.UID == u
+	return p.Role(u).CanEdit()
 }
 
 func (p ProfileID) Link() string {
@@ -58,8 +69,10 @@ type Bookmark struct {
 	LegislationID legislature.LegislationID // Legislation Key
 	UID           UID                       `json:"-"` // User ID
 
-	Oppose bool
-	Rank   []bool `json:"-"` // sortable
+	Oppose   bool     // true for OpposePosition; see SetPosition
+	Position Position `firestore:",omitempty" json:",omitempty"` // empty for bookmarks saved before Position; see GetPosition
+	Rank     int      `firestore:",omitempty" json:",omitempty"` // priority; 1 sorts first, 0 is unranked. Bookmarks can share a rank (a tier)
+	Weight   int      `firestore:",omitempty" json:",omitempty"` // scorecard weight; 0 is 1
 
 	Created      time.Time
 	LastModified time.Time
@@ -104,8 +117,8 @@ type DisplayTag struct {
 
 func (b Bookmark) DisplayTags() []DisplayTag {
 	out := make([]DisplayTag, 0, len(b.Tags))
-	if b.Oppose {
-		out = append(out, DisplayTag{Tag: "Oppose", Class: "oppose"})
+	if p := b.GetPosition(); p != SupportPosition {
+		out = append(out, DisplayTag{Tag: p.Label(), Class: string(p)})
 	}
 	if b.BicameralBody != nil && b.BicameralBody.UpperHouse {
 		out = append(out, DisplayTag{Tag: b.BicameralBody.DisplayID, Class: "body"})
@@ -118,9 +131,9 @@ func (b Bookmark) DisplayTags() []DisplayTag {
 	}
 	if b.Legislation != nil {
 		out = append(out, DisplayTag{Tag: "session:" + b.Legislation.Session.String(), Class: "session"})
-		// if len(b.Legislation.Sponsors) > 0 {
-		// 	out = append(out, DisplayTag{Tag: "sponsor:" + b.Legislation.Sponsors[0].FullName, Class: "sponsor"})
-		// }
+		if p := b.Legislation.PrimeSponsor(); p != nil {
+			out = append(out, DisplayTag{Tag: "sponsor:" + p.FullName, Class: "sponsor"})
+		}
 		if b.Legislation.Type == legislature.ResolutionType {
 			out = append(out, DisplayTag{Tag: "Resolution", Class: "resolution"})
 		}
@@ -131,10 +144,37 @@ func (b Bookmark) DisplayTags() []DisplayTag {
 	return out
 }
 
-// FilterTag
+// SponsorTags returns the "sponsor:" display tags for prime sponsors
+func (b Bookmarks) SponsorTags() []DisplayTag {
+	tags := make(map[DisplayTag]bool)
+	for _, bb := range b {
+		for _, tag := range bb.DisplayTags() {
+			if tag.Class == "sponsor" {
+				tags[tag] = true
+			}
+		}
+	}
+	out := make([]DisplayTag, 0, len(tags))
+	for tag := range tags {
+		out = append(out, tag)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Tag < out[j].Tag })
+	return out
+}
+
+// FilterTag matches a user tag or a "sponsor:" tag for the prime sponsor
 func (b Bookmarks) FilterTag(tag string) Bookmarks {
 	var out Bookmarks
 	for _, bb := range b {
+		if strings.HasPrefix(tag, "sponsor:") {
+			if bb.Legislation == nil {
+				continue
+			}
+			if p := bb.Legislation.PrimeSponsor(); p != nil && "sponsor:"+p.FullName == tag {
+				out = append(out, bb)
+			}
+			continue
+		}
 		for _, t := range bb.Tags {
 			if t == tag {
 				out = append(out, bb)
@@ -162,29 +202,67 @@ func (b Bookmarks) Filter(body ...legislature.BodyID) Bookmarks {
 func (b Bookmarks) Active() Bookmarks {
 	var out Bookmarks
 	for _, bb := range b {
-		if bb.Legislation.Session.Active() {
+		if bb.Legislation.Active() {
 			out = append(out, bb)
 		}
 	}
 	return out
 }
+
+// CountSupported includes bills supported if amended
 func (b Bookmarks) CountSupported() int {
-	var n int
+	return b.CountPosition(SupportPosition, SupportIfAmendedPosition)
+}
+func (b Bookmarks) CountOpposed() int {
+	return b.CountPosition(OpposePosition)
+}
+
+// CountWatched includes neutral bills
+func (b Bookmarks) CountWatched() int {
+	return b.CountPosition(WatchPosition, NeutralPosition)
+}
+
+// TopPriorityCount is the number of ranked bookmarks highlighted on a profile
+const TopPriorityCount = 5
+
+// Priorities returns up to n ranked bookmarks in priority order
+func (b Bookmarks) Priorities(n int) Bookmarks {
+	var out Bookmarks
 	for _, bb := range b {
-		if !bb.Oppose {
-			n++
+		if bb.Rank > 0 {
+			out = append(out, bb)
 		}
 	}
-	return n
+	sort.Stable(SortedBookmarks(out))
+	if len(out) > n {
+		out = out[:n]
+	}
+	return out
 }
-func (b Bookmarks) CountOpposed() int {
-	var n int
-	for _, bb := range b {
-		if bb.Oppose {
-			n++
+
+// Ranked returns all ranked bookmarks in priority order
+func (b Bookmarks) Ranked() Bookmarks {
+	return b.Priorities(len(b))
+}
+
+// TopPriorities returns the first TopPriorityCount ranked bookmarks
+func (b Bookmarks) TopPriorities() Bookmarks {
+	return b.Priorities(TopPriorityCount)
+}
+
+// Rank sets the priority order to keys (see Bookmark.Key) ranking them 1, 2, 3...; other
+// bookmarks are unranked. It returns the bookmarks that changed
+func (b Bookmarks) Rank(keys []string) Bookmarks {
+	var out Bookmarks
+	for i := range b {
+		rank := slices.Index(keys, b[i].Key()) + 1
+		if b[i].Rank == rank {
+			continue
 		}
+		b[i].Rank = rank
+		out = append(out, b[i])
 	}
-	return n
+	return out
 }
 
 func (b Bookmarks) Bodies() []legislature.BodyID {
@@ -204,10 +282,13 @@ func (b Bookmarks) Bodies() []legislature.BodyID {
 	return bodies
 }
 
+// NewScore is the scorecard entry for a bookmark; bills supported if amended are scored as supported.
+// Watched and neutral bookmarks have no desired outcome and are left off scorecards (see Scored)
 func (b Bookmark) NewScore() legislature.ScoredBookmark {
 	return legislature.ScoredBookmark{
 		Legislation: b.Legislation,
-		Oppose:      b.Oppose,
+		Oppose:      b.GetPosition() == OpposePosition,
+		Weight:      b.Weight,
 		// Tags: b.Tags,
 	}
 }
@@ -246,6 +327,16 @@ func (s SortedBookmarks) Len() int      { return len(s) }
 func (s SortedBookmarks) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
 func (s SortedBookmarks) Less(i, j int) bool {
 	a, b := s[i], s[j]
+	// ranked bookmarks lead; equal ranks fall back to the body and legislation order
+	if a.Rank != b.Rank {
+		switch {
+		case a.Rank == 0:
+			return false
+		case b.Rank == 0:
+			return true
+		}
+		return a.Rank < b.Rank
+	}
 	b1, b2 := a.UpperBody(), b.UpperBody()
 	if b1 == nil {
 		b1 = a.LowerBody()
@@ -266,7 +357,7 @@ func (s SortedBookmarks) Less(i, j int) bool {
 func IsValidProfileID(s ProfileID) bool {
 	switch s {
 	case "", "sign_out", "sign_in", "about",
-		"session", "static", "search":
+		"session", "static", "search", "api":
 		return false
 	}
 	if strings.IndexFunc(string(s), func(r rune) bool { return (r != '-' && unicode.IsPunct(r)) || unicode.IsSpace(r) }) != -1 {
@@ -2,7 +2,10 @@ package account
 
 import (
 	"fmt"
+	"sort"
 	"testing"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
 )
 
 func TestIsValidProfileID(t *testing.T) {
@@ -27,3 +30,78 @@ func TestIsValidProfileID(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterTagSponsor(t *testing.T) {
+	leg := func(prime string) *legislature.Legislation {
+		return &legislature.Legislation{Sponsors: []legislature.Member{
+			{FullName: "Someone Else", Role: legislature.Cosponsor},
+			{FullName: prime, Role: legislature.PrimeSponsor},
+		}}
+	}
+	b := Bookmarks{
+		{LegislationID: "1", Legislation: leg("Jane Doe"), Tags: []string{"transit"}},
+		{LegislationID: "2", Legislation: leg("John Roe")},
+		{LegislationID: "3", Legislation: leg("Jane Doe")},
+		{LegislationID: "4"},
+	}
+	type testCase struct {
+		tag  string
+		want []legislature.LegislationID
+	}
+	tests := []testCase{
+		{"sponsor:Jane Doe", []legislature.LegislationID{"1", "3"}},
+		{"sponsor:Someone Else", nil},
+		{"transit", []legislature.LegislationID{"1"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.tag, func(t *testing.T) {
+			var got []legislature.LegislationID
+			for _, bb := range b.FilterTag(tc.tag) {
+				got = append(got, bb.LegislationID)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+				t.Errorf("got %v want %v", got, tc.want)
+			}
+		})
+	}
+	if got := b.SponsorTags(); len(got) != 2 || got[0].Tag != "sponsor:Jane Doe" {
+		t.Errorf("unexpected SponsorTags %v", got)
+	}
+}
+
+func TestSortedBookmarksRank(t *testing.T) {
+	body := &legislature.Body{ID: "nyc", Name: "NYC", Sort: legislature.GenericLegislationSort}
+	bookmark := func(id legislature.LegislationID, rank int) Bookmark {
+		return Bookmark{BodyID: "nyc", LegislationID: id, Rank: rank, Body: body, Legislation: &legislature.Legislation{Body: "nyc", ID: id}}
+	}
+	b := Bookmarks{
+		bookmark("1", 0),
+		bookmark("2", 2),
+		bookmark("3", 0),
+		bookmark("4", 1),
+		bookmark("5", 2),
+	}
+	ids := func(b Bookmarks) string {
+		var out []legislature.LegislationID
+		for _, bb := range b {
+			out = append(out, bb.LegislationID)
+		}
+		return fmt.Sprint(out)
+	}
+	sort.Sort(SortedBookmarks(b))
+	if got := ids(b); got != "[4 2 5 1 3]" {
+		t.Errorf("sorted got %s", got)
+	}
+	if got := ids(b.Priorities(2)); got != "[4 2]" {
+		t.Errorf("Priorities got %s", got)
+	}
+
+	changed := b.Rank([]string{"nyc.3", "nyc.4", "nyc.2"})
+	if got := ids(changed); got != "[4 2 5 3]" {
+		t.Errorf("Rank changed got %s", got)
+	}
+	sort.Sort(SortedBookmarks(b))
+	if got := ids(b); got != "[3 4 2 1 5]" {
+		t.Errorf("sorted after Rank got %s", got)
+	}
+}
@@ -1,9 +1,33 @@
 package account
 
+import (
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
 type ScorecardOptions struct {
 	HideDistrict      bool
 	HideBillStatus    bool
 	HideSupportOppose bool
 	ShowPercent       bool
 	HideParty         bool
+
+	// ScoringPolicy is nil for legislature.DefaultScoringPolicy
+	ScoringPolicy *legislature.ScoringPolicy `firestore:",omitempty" json:",omitempty"`
+}
+
+// Policy returns the ScoringPolicy or the default
+func (o ScorecardOptions) Policy() legislature.ScoringPolicy {
+	if o.ScoringPolicy != nil {
+		return *o.ScoringPolicy
+	}
+	return legislature.DefaultScoringPolicy
+}
+
+// SetPolicy stores p; the default policy is stored as nil
+func (o *ScorecardOptions) SetPolicy(p legislature.ScoringPolicy) {
+	if p == legislature.DefaultScoringPolicy {
+		o.ScoringPolicy = nil
+		return
+	}
+	o.ScoringPolicy = &p
 }
@@ -0,0 +1,24 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+func TestScorecardOptionsPolicy(t *testing.T) {
+	var o ScorecardOptions
+	if o.Policy() != legislature.DefaultScoringPolicy {
+		t.Errorf("expected default policy got %#v", o.Policy())
+	}
+	p := o.Policy()
+	p.PrimeSponsorBonus = 1
+	o.SetPolicy(p)
+	if o.ScoringPolicy == nil || o.Policy().PrimeSponsorBonus != 1 {
+		t.Errorf("expected policy to be set got %#v", o.ScoringPolicy)
+	}
+	o.SetPolicy(legislature.DefaultScoringPolicy)
+	if o.ScoringPolicy != nil {
+		t.Errorf("expected default policy to be stored as nil got %#v", o.ScoringPolicy)
+	}
+}
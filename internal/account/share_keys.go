@@ -0,0 +1,68 @@
+package account
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ShareKey is a secret that grants read access to a private profile (i.e. /{profile}?key=...)
+type ShareKey struct {
+	Key       string
+	Label     string `firestore:",omitempty" json:",omitempty"` // i.e. who the link was sent to
+	CreatedBy UID
+	Created   time.Time
+}
+
+var ErrShareKeyNotFound = errors.New("share link not found")
+
+func newShareKey() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// AddShareKey creates a new share key
+func (p *Profile) AddShareKey(label string, by UID, now time.Time) (ShareKey, error) {
+	key, err := newShareKey()
+	if err != nil {
+		return ShareKey{}, err
+	}
+	k := ShareKey{Key: key, Label: strings.TrimSpace(label), CreatedBy: by, Created: now}
+	p.ShareKeys = append(p.ShareKeys, k)
+	return k, nil
+}
+
+// RevokeShareKey removes a share key; existing links stop working
+func (p *Profile) RevokeShareKey(key string) error {
+	n := len(p.ShareKeys)
+	p.ShareKeys = slices.DeleteFunc(p.ShareKeys, func(k ShareKey) bool { return k.Key == key })
+	if len(p.ShareKeys) == n {
+		return ErrShareKeyNotFound
+	}
+	return nil
+}
+
+// IsShareKey returns if key is a valid share key
+func (p Profile) IsShareKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, k := range p.ShareKeys {
+		if subtle.ConstantTimeCompare([]byte(k.Key), []byte(key)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// CanView returns if the profile is public, u is a member, or key is a valid share key
+func (p Profile) CanView(u UID, key string) bool {
+	return !p.Private || p.Role(u).CanView() || p.IsShareKey(key)
+}
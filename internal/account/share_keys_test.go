@@ -0,0 +1,59 @@
+package account
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestProfileCanView(t *testing.T) {
+	p := Profile{
+		UID:     "owner",
+		Private: true,
+		Members: []ProfileMember{{UID: "viewer", Role: ViewerRole}},
+	}
+	k, err := p.AddShareKey(" board ", "owner", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(k.Key) < 32 || k.Label != "board" {
+		t.Fatalf("unexpected share key %#v", k)
+	}
+
+	type testCase struct {
+		name string
+		uid  UID
+		key  string
+		want bool
+	}
+	tests := []testCase{
+		{"owner", "owner", "", true},
+		{"viewer", "viewer", "", true},
+		{"signed in", "other", "", false},
+		{"anonymous", "", "", false},
+		{"share key", "", k.Key, true},
+		{"invalid key", "", "invalid", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := p.CanView(tc.uid, tc.key); got != tc.want {
+				t.Errorf("got %v expected %v", got, tc.want)
+			}
+		})
+	}
+
+	if err := p.RevokeShareKey(k.Key); err != nil {
+		t.Fatal(err)
+	}
+	if p.CanView("", k.Key) {
+		t.Errorf("expected revoked key to not have access")
+	}
+	if err := p.RevokeShareKey(k.Key); !errors.Is(err, ErrShareKeyNotFound) {
+		t.Errorf("got %v expected ErrShareKeyNotFound", err)
+	}
+
+	p.Private = false
+	if !p.CanView("", "") {
+		t.Errorf("expected public profile to be visible")
+	}
+}
@@ -16,6 +16,8 @@ func StatusText(code int) string {
 	switch code {
 	case 200:
 		return "OK"
+	case 201:
+		return "Created"
 	case 400:
 		return "Bad Request"
 	case 401:
@@ -24,6 +26,10 @@ func StatusText(code int) string {
 		return "Forbidden"
 	case 404:
 		return "Not Found"
+	case 409:
+		return "Conflict"
+	case 422:
+		return "Unprocessable Entity"
 	case 500:
 		return "Internal Server Error"
 	default:
@@ -35,6 +41,10 @@ func OK200(w http.ResponseWriter, data any) {
 	writeJSONResponse(w, 200, data)
 }
 
+func Created201(w http.ResponseWriter, data any) {
+	writeJSONResponse(w, 201, data)
+}
+
 func BadRequest400(w http.ResponseWriter, message string) {
 	writeJSONResponse(w, 400, message)
 }
@@ -0,0 +1,717 @@
+package datastore
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/account"
+	"github.com/jehiah/legislation.support/internal/legislature"
+	"github.com/jehiah/legislation.support/internal/resolvers"
+	log "github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BoltStore is a Store backed by a local bbolt database file for use on a laptop
+// or in a self-hosted deployment. Queries scan the relevant bucket.
+//
+// Records are gob encoded in nested buckets that mirror the Firestore collections
+//
+//	profiles/{profile}
+//	redirects/{from}
+//	api_tokens/{hash}
+//	bookmarks/{profile}/{body.legislation}
+//	bookmark_history/{profile}/{sequence}
+//	bills/{body}/{legislation}
+//	changes/{body}/{legislation}
+type BoltStore struct {
+	db *bolt.DB
+}
+
+func NewBolt(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	return &BoltStore{db: db}, nil
+}
+
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+// bucket returns the (possibly nested) bucket at path or nil if it doesn't exist
+func bucket(tx *bolt.Tx, path ...string) *bolt.Bucket {
+	b := tx.Bucket([]byte(path[0]))
+	for _, p := range path[1:] {
+		if b == nil {
+			return nil
+		}
+		b = b.Bucket([]byte(p))
+	}
+	return b
+}
+
+func createBucket(tx *bolt.Tx, path ...string) (*bolt.Bucket, error) {
+	b, err := tx.CreateBucketIfNotExists([]byte(path[0]))
+	for _, p := range path[1:] {
+		if err != nil {
+			return nil, err
+		}
+		b, err = b.CreateBucketIfNotExists([]byte(p))
+	}
+	return b, err
+}
+
+func decode(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// get decodes the record at key into v. A missing record is a NotFound error
+func get(tx *bolt.Tx, v any, key string, path ...string) error {
+	var data []byte
+	if b := bucket(tx, path...); b != nil {
+		data = b.Get([]byte(key))
+	}
+	if data == nil {
+		return status.Errorf(codes.NotFound, "%s/%s not found", strings.Join(path, "/"), key)
+	}
+	return decode(data, v)
+}
+
+func exists(tx *bolt.Tx, key string, path ...string) bool {
+	b := bucket(tx, path...)
+	return b != nil && b.Get([]byte(key)) != nil
+}
+
+func put(tx *bolt.Tx, v any, key string, path ...string) error {
+	b, err := createBucket(tx, path...)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	err = gob.NewEncoder(&buf).Encode(v)
+	if err != nil {
+		return err
+	}
+	return b.Put([]byte(key), buf.Bytes())
+}
+
+// create is like put but returns an AlreadyExists error if the record exists
+func create(tx *bolt.Tx, v any, key string, path ...string) error {
+	if exists(tx, key, path...) {
+		return status.Errorf(codes.AlreadyExists, "%s/%s already exists", strings.Join(path, "/"), key)
+	}
+	return put(tx, v, key, path...)
+}
+
+func del(tx *bolt.Tx, key string, path ...string) error {
+	b := bucket(tx, path...)
+	if b == nil {
+		return nil
+	}
+	return b.Delete([]byte(key))
+}
+
+// each decodes every record in the bucket at path
+func each[T any](tx *bolt.Tx, fn func(key string, v T) error, path ...string) error {
+	b := bucket(tx, path...)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, data []byte) error {
+		if data == nil {
+			// nested bucket
+			return nil
+		}
+		var v T
+		if err := decode(data, &v); err != nil {
+			return err
+		}
+		return fn(string(k), v)
+	})
+}
+
+// eachBucket calls fn with the name of every nested bucket at path
+func eachBucket(tx *bolt.Tx, fn func(name string) error, path ...string) error {
+	b := bucket(tx, path...)
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(k, data []byte) error {
+		if data != nil {
+			return nil
+		}
+		return fn(string(k))
+	})
+}
+
+// union appends the elements of b not already in a like firestore.ArrayUnion
+func union[T comparable](a, b []T) []T {
+	for _, v := range b {
+		if !slices.Contains(a, v) {
+			a = append(a, v)
+		}
+	}
+	return a
+}
+
+// stripBookmark removes the fields that are not persisted
+func stripBookmark(b account.Bookmark) account.Bookmark {
+	b.Body, b.BicameralBody, b.Legislation = nil, nil, nil
+	return b
+}
+
+// stripBill removes the fields that are not persisted (amendment text)
+func stripBill(l legislature.Legislation) legislature.Legislation {
+	if len(l.Amendments) == 0 {
+		return l
+	}
+	amendments := make([]legislature.Amendment, len(l.Amendments))
+	copy(amendments, l.Amendments)
+	for i := range amendments {
+		amendments[i].Text = ""
+	}
+	l.Amendments = amendments
+	return l
+}
+
+func (s *BoltStore) GetProfile(ctx context.Context, ID account.ProfileID) (*account.Profile, error) {
+	if !account.IsValidProfileID(ID) {
+		return nil, nil
+	}
+	var p account.Profile
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return get(tx, &p, string(ID), "profiles")
+	})
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (s *BoltStore) GetProfiles(ctx context.Context, UID account.UID) ([]account.Profile, error) {
+	var out []account.Profile
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return each(tx, func(_ string, p account.Profile) error {
+			if p.UID == UID || slices.Contains(p.MemberUIDs, UID) {
+				out = append(out, p)
+			}
+			return nil
+		}, "profiles")
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	if len(out) > 100 {
+		out = out[:100]
+	}
+	return out, err
+}
+
+func (s *BoltStore) GetInvitations(ctx context.Context, email string) ([]account.Profile, error) {
+	var out []account.Profile
+	if email == "" {
+		return nil, nil
+	}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return each(tx, func(_ string, p account.Profile) error {
+			if slices.Contains(p.InvitedEmails, email) {
+				out = append(out, p)
+			}
+			return nil
+		}, "profiles")
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, err
+}
+
+func (s *BoltStore) CreateProfile(ctx context.Context, p account.Profile) error {
+	p.LastModified = time.Now().UTC()
+	log.Printf("creating profile %#v", p)
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return create(tx, p, string(p.ID), "profiles")
+	})
+}
+
+func (s *BoltStore) UpdateProfile(ctx context.Context, p account.Profile) error {
+	p.LastModified = time.Now().UTC()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return put(tx, p, string(p.ID), "profiles")
+	})
+}
+
+func (s *BoltStore) RenameProfile(ctx context.Context, old, newID account.ProfileID, user account.UID) error {
+	log.Infof("rename %q => %q", old, newID)
+	return s.db.Update(func(tx *bolt.Tx) error {
+		var p account.Profile
+		err := get(tx, &p, string(old), "profiles")
+		if IsNotFound(err) {
+			return fmt.Errorf("profile %s not found", old)
+		} else if err != nil {
+			return err
+		}
+		p.ID = newID
+		err = create(tx, p, string(newID), "profiles")
+		if err != nil {
+			return err
+		}
+		err = put(tx, newRedirect(old, newID, user), string(old), "redirects")
+		if err != nil {
+			return err
+		}
+		err = each(tx, func(key string, b account.Bookmark) error {
+			return create(tx, b, key, "bookmarks", string(newID))
+		}, "bookmarks", string(old))
+		if err != nil {
+			return err
+		}
+		err = each(tx, func(key string, h account.BookmarkHistory) error {
+			return create(tx, h, key, "bookmark_history", string(newID))
+		}, "bookmark_history", string(old))
+		if err != nil {
+			return err
+		}
+		return deleteProfile(tx, old)
+	})
+}
+
+func (s *BoltStore) DeleteProfile(ctx context.Context, ID account.ProfileID) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return deleteProfile(tx, ID)
+	})
+}
+
+// deleteProfile removes a profile with its bookmarks and bookmark history
+func deleteProfile(tx *bolt.Tx, ID account.ProfileID) error {
+	for _, name := range []string{"bookmarks", "bookmark_history"} {
+		if b := bucket(tx, name); b != nil && b.Bucket([]byte(ID)) != nil {
+			if err := b.DeleteBucket([]byte(ID)); err != nil {
+				return err
+			}
+		}
+	}
+	return del(tx, string(ID), "profiles")
+}
+
+func (s *BoltStore) CreateAPIToken(ctx context.Context, t account.APIToken) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return create(tx, t, t.Hash, "api_tokens")
+	})
+}
+
+func (s *BoltStore) UpdateAPIToken(ctx context.Context, t account.APIToken) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return put(tx, t, t.Hash, "api_tokens")
+	})
+}
+
+func (s *BoltStore) GetAPIToken(ctx context.Context, hash string) (*account.APIToken, error) {
+	if hash == "" {
+		return nil, nil
+	}
+	var t account.APIToken
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return get(tx, &t, hash, "api_tokens")
+	})
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (s *BoltStore) GetAPITokens(ctx context.Context, UID account.UID) ([]account.APIToken, error) {
+	var out []account.APIToken
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return each(tx, func(_ string, t account.APIToken) error {
+			if t.UID == UID {
+				out = append(out, t)
+			}
+			return nil
+		}, "api_tokens")
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].Created.After(out[j].Created) })
+	return out, err
+}
+
+func (s *BoltStore) DeleteAPIToken(ctx context.Context, hash string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return del(tx, hash, "api_tokens")
+	})
+}
+
+func newRedirect(from, to account.ProfileID, UID account.UID) account.ProfileRedirect {
+	return account.ProfileRedirect{
+		From:    from,
+		To:      to,
+		UID:     UID,
+		Created: time.Now().UTC(),
+	}
+}
+
+func (s *BoltStore) CreateRedirect(ctx context.Context, from, to account.ProfileID, UID account.UID) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return put(tx, newRedirect(from, to, UID), string(from), "redirects")
+	})
+}
+
+func (s *BoltStore) GetRedirect(ctx context.Context, from account.ProfileID) (*account.ProfileRedirect, error) {
+	var r account.ProfileRedirect
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return get(tx, &r, string(from), "redirects")
+	})
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (s *BoltStore) SaveBookmark(ctx context.Context, p account.ProfileID, b account.Bookmark) error {
+	b.LastModified = time.Now().UTC()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return create(tx, stripBookmark(b), b.Key(), "bookmarks", string(p))
+	})
+}
+
+func (s *BoltStore) UpdateBookmark(ctx context.Context, p account.ProfileID, b account.Bookmark) error {
+	b.LastModified = time.Now().UTC()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return put(tx, stripBookmark(b), b.Key(), "bookmarks", string(p))
+	})
+}
+
+func (s *BoltStore) DeleteBookmark(ctx context.Context, p account.ProfileID, b legislature.BodyID, l legislature.LegislationID) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return del(tx, account.BookmarkKey(b, l), "bookmarks", string(p))
+	})
+}
+
+func (s *BoltStore) GetBookmark(ctx context.Context, p account.ProfileID, key string) (*account.Bookmark, error) {
+	if !account.IsValidProfileID(p) {
+		return nil, nil
+	}
+	var b account.Bookmark
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return get(tx, &b, key, "bookmarks", string(p))
+	})
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// AddBookmarkHistory keys entries by a sequence so they are kept in the order they were added
+func (s *BoltStore) AddBookmarkHistory(ctx context.Context, p account.ProfileID, h account.BookmarkHistory) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b, err := createBucket(tx, "bookmark_history", string(p))
+		if err != nil {
+			return err
+		}
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		return create(tx, h, fmt.Sprintf("%016d", seq), "bookmark_history", string(p))
+	})
+}
+
+func (s *BoltStore) GetBookmarkHistory(ctx context.Context, p account.ProfileID, body legislature.BodyID, id legislature.LegislationID) ([]account.BookmarkHistory, error) {
+	history, err := s.GetProfileBookmarkHistory(ctx, p)
+	var out []account.BookmarkHistory
+	for _, h := range history {
+		if h.BodyID == body && h.LegislationID == id {
+			out = append(out, h)
+		}
+	}
+	return out, err
+}
+
+func (s *BoltStore) GetProfileBookmarkHistory(ctx context.Context, p account.ProfileID) ([]account.BookmarkHistory, error) {
+	var out []account.BookmarkHistory
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return each(tx, func(_ string, h account.BookmarkHistory) error {
+			out = append(out, h)
+			return nil
+		}, "bookmark_history", string(p))
+	})
+	slices.Reverse(out)
+	sort.Stable(account.SortedBookmarkHistory(out))
+	return out, err
+}
+
+func (s *BoltStore) GetProfileBookmarks(ctx context.Context, profileID account.ProfileID) (account.Bookmarks, error) {
+	var out account.Bookmarks
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return each(tx, func(_ string, b account.Bookmark) error {
+			body := resolvers.Bodies[b.BodyID]
+			b.Body = &body
+			var l legislature.Legislation
+			err := get(tx, &l, string(b.LegislationID), "bills", string(b.BodyID))
+			if err != nil {
+				return err
+			}
+			b.Legislation = &l
+			if l.SameAs != "" {
+				body := resolvers.Bodies[b.Body.Bicameral]
+				b.BicameralBody = &body
+			}
+			out = append(out, b)
+			return nil
+		}, "bookmarks", string(profileID))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateBill updates a with a newer copy b. It's expected that a is already in the DB
+func (s *BoltStore) UpdateBill(ctx context.Context, a, b legislature.Legislation) (staleSameAs bool, err error) {
+	if a.SameAs == "" && b.SameAs != "" {
+		staleSameAs = true
+	}
+
+	changes := legislature.CalculateChanges(a, b)
+	b.LastChecked = time.Now().UTC()
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		if !changes.Empty() {
+			log.Debugf("changes %s %s %#v", b.Body, b.ID, changes)
+			var c legislature.Changes
+			err := get(tx, &c, string(b.ID), "changes", string(b.Body))
+			if err != nil && !IsNotFound(err) {
+				return err
+			}
+			c.Sponsors = union(c.Sponsors, changes.Sponsors)
+			c.Status = union(c.Status, changes.Status)
+			c.Amendments = union(c.Amendments, changes.Amendments)
+			err = put(tx, c, string(b.ID), "changes", string(b.Body))
+			if err != nil {
+				return err
+			}
+		}
+		return put(tx, stripBill(b), string(a.ID), "bills", string(a.Body))
+	})
+	return
+}
+
+// SaveBill creates a bill in the database or updates an existing bill with a diff of SponsorChanges.
+// New bills record the sponsor history from upstream sponsor dates (see legislature.SponsorHistory)
+//
+// If SameAs is set and it doesn't exist in the database `staleSameAs` will be set to true
+func (s *BoltStore) SaveBill(ctx context.Context, b legislature.Legislation) (staleSameAs bool, err error) {
+	b.Added = time.Now().UTC()
+	b.LastChecked = time.Now().UTC()
+	var existing *legislature.Legislation
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		var a legislature.Legislation
+		err := get(tx, &a, string(b.ID), "bills", string(b.Body))
+		if err == nil {
+			existing = &a
+			return nil
+		} else if !IsNotFound(err) {
+			return err
+		}
+		err = put(tx, stripBill(b), string(b.ID), "bills", string(b.Body))
+		if err != nil {
+			return err
+		}
+		if history := legislature.SponsorHistory(b); len(history) > 0 {
+			err = put(tx, legislature.Changes{Sponsors: history}, string(b.ID), "changes", string(b.Body))
+			if err != nil {
+				return err
+			}
+		}
+		if b.SameAs != "" {
+			// check if sameAs exists
+			sameAsBody := resolvers.Bodies[b.Body].Bicameral
+			staleSameAs = !exists(tx, string(b.SameAs), "bills", string(sameAsBody))
+		}
+		return nil
+	})
+	if err == nil && existing != nil {
+		staleSameAs, err = s.UpdateBill(ctx, *existing, b)
+	}
+	return
+}
+
+func (s *BoltStore) GetBill(ctx context.Context, body legislature.BodyID, id legislature.LegislationID) (*legislature.Legislation, error) {
+	var l legislature.Legislation
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return get(tx, &l, string(id), "bills", string(body))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// allBills returns all bills that match filter
+func (s *BoltStore) allBills(filter func(l legislature.Legislation) bool) ([]legislature.Legislation, error) {
+	var out []legislature.Legislation
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return eachBucket(tx, func(body string) error {
+			return each(tx, func(_ string, l legislature.Legislation) error {
+				if filter(l) {
+					out = append(out, l)
+				}
+				return nil
+			}, "bills", body)
+		}, "bills")
+	})
+	return out, err
+}
+
+// GetStaleBills gets bills for bodies in an active session that have not been checked recently
+func (s *BoltStore) GetStaleBills(ctx context.Context, limit int, bodies []legislature.BodyID) ([]legislature.Legislation, error) {
+	target := time.Hour * 6
+	now := time.Now().UTC()
+	cutoff := now.Add(-1 * target)
+	out, err := s.allBills(func(l legislature.Legislation) bool {
+		// a session ending last year can still be active (i.e. a lame duck period in January)
+		return l.LastChecked.Before(cutoff) && l.Session.EndYear >= now.Year()-1 && l.ActiveAt(now) && slices.Contains(bodies, l.Body)
+	})
+	sort.SliceStable(out, func(i, j int) bool { return out[i].LastChecked.Before(out[j].LastChecked) })
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, err
+}
+
+func (s *BoltStore) GetRecentBills(ctx context.Context, limit int) ([]legislature.Legislation, error) {
+	if limit == 0 || limit > 1000 {
+		limit = 20
+	}
+	out, err := s.allBills(func(legislature.Legislation) bool { return true })
+	sort.Slice(out, func(i, j int) bool { return out[i].Added.After(out[j].Added) })
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, err
+}
+
+// GetAllBills iterates over all bills
+func (s *BoltStore) GetAllBills(ctx context.Context, callback func(l legislature.Legislation) error) error {
+	// collect first; callback may write to the database which can't happen inside a read transaction
+	bills, err := s.allBills(func(legislature.Legislation) bool { return true })
+	if err != nil {
+		return err
+	}
+	for _, l := range bills {
+		err = callback(l)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetProfileChanges returns all bills regardless of if any chagnes were detected
+func (s *BoltStore) GetProfileChanges(ctx context.Context, profileID account.ProfileID) ([]BookmarkChanges, error) {
+	var out []BookmarkChanges
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return each(tx, func(_ string, b account.Bookmark) error {
+			body := resolvers.Bodies[b.BodyID]
+			b.Body = &body
+			if body.Bicameral != "" {
+				body := resolvers.Bodies[body.Bicameral]
+				b.BicameralBody = &body
+			}
+			bc := BookmarkChanges{Bookmark: b}
+			var l legislature.Legislation
+			err := get(tx, &l, string(b.LegislationID), "bills", string(b.BodyID))
+			if err != nil && !IsNotFound(err) {
+				return err
+			}
+			bc.Legislation = &l
+			err = get(tx, &bc.Changes, string(b.LegislationID), "changes", string(b.BodyID))
+			if err != nil && !IsNotFound(err) {
+				return err
+			}
+			if l.SameAs != "" {
+				err = get(tx, &bc.SameAsChanges, string(l.SameAs), "changes", string(body.Bicameral))
+				if err != nil && !IsNotFound(err) {
+					return err
+				}
+			}
+			out = append(out, bc)
+			return nil
+		}, "bookmarks", string(profileID))
+	})
+	return out, err
+}
+
+func (s *BoltStore) GetChanges(ctx context.Context, body legislature.BodyID, id legislature.LegislationID) (legislature.Changes, error) {
+	var r legislature.Changes
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return get(tx, &r, string(id), "changes", string(body))
+	})
+	if IsNotFound(err) {
+		return r, nil
+	}
+	return r, err
+}
+
+// GetAllChanges iterates over the changes recorded for all bills
+func (s *BoltStore) GetAllChanges(ctx context.Context, callback func(id legislature.GlobalID, c legislature.Changes) error) error {
+	type record struct {
+		id legislature.GlobalID
+		c  legislature.Changes
+	}
+	var records []record
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return eachBucket(tx, func(body string) error {
+			return each(tx, func(key string, c legislature.Changes) error {
+				records = append(records, record{
+					id: legislature.GlobalID{BodyID: legislature.BodyID(body), LegislationID: legislature.LegislationID(key)},
+					c:  c,
+				})
+				return nil
+			}, "changes", body)
+		}, "changes")
+	})
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		err = callback(r.id, r.c)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveSponsorChanges removes specific sponsor changes recorded for a bill
+func (s *BoltStore) RemoveSponsorChanges(ctx context.Context, id legislature.GlobalID, remove []legislature.SponsorChange) error {
+	if len(remove) == 0 {
+		return nil
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		var c legislature.Changes
+		err := get(tx, &c, string(id.LegislationID), "changes", string(id.BodyID))
+		if err != nil {
+			return err
+		}
+		c.Sponsors = slices.DeleteFunc(c.Sponsors, func(sc legislature.SponsorChange) bool {
+			return slices.Contains(remove, sc)
+		})
+		return put(tx, c, string(id.LegislationID), "changes", string(id.BodyID))
+	})
+}
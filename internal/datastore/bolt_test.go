@@ -0,0 +1,316 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/account"
+	"github.com/jehiah/legislation.support/internal/legislature"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestBoltStore(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewBolt(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	var _ Store = db
+
+	profile := account.Profile{ID: "test-profile", Name: "Test", UID: "user"}
+	if err = db.CreateProfile(ctx, profile); err != nil {
+		t.Fatal(err)
+	}
+	if err = db.CreateProfile(ctx, profile); !IsAlreadyExists(err) {
+		t.Fatalf("expected AlreadyExists got %v", err)
+	}
+	if p, err := db.GetProfiles(ctx, "user"); err != nil || len(p) != 1 {
+		t.Fatalf("got %d profiles err %v", len(p), err)
+	}
+
+	// invitations and members
+	if _, err = profile.Invite("member@example.com", account.EditorRole, "user", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err = db.UpdateProfile(ctx, profile); err != nil {
+		t.Fatal(err)
+	}
+	if p, err := db.GetInvitations(ctx, "member@example.com"); err != nil || len(p) != 1 {
+		t.Fatalf("got %d invitations err %v", len(p), err)
+	}
+	if err = profile.AcceptInvitation("member@example.com", "member", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err = db.UpdateProfile(ctx, profile); err != nil {
+		t.Fatal(err)
+	}
+	if p, err := db.GetProfiles(ctx, "member"); err != nil || len(p) != 1 || p[0].Role("member") != account.EditorRole {
+		t.Fatalf("got %#v profiles err %v", p, err)
+	}
+	if p, err := db.GetInvitations(ctx, "member@example.com"); err != nil || len(p) != 0 {
+		t.Fatalf("got %d invitations err %v", len(p), err)
+	}
+
+	bill := legislature.Legislation{
+		Body:        "nyc",
+		ID:          "0001-2024",
+		Session:     legislature.Session{StartYear: 2024, EndYear: time.Now().Year()},
+		Sponsors:    []legislature.Member{{Slug: "a"}},
+		LastChecked: time.Now().Add(-24 * time.Hour),
+	}
+	if _, err = db.SaveBill(ctx, bill); err != nil {
+		t.Fatal(err)
+	}
+	bookmark := account.Bookmark{BodyID: bill.Body, LegislationID: bill.ID, UID: "user", Tags: []string{"tag"}}
+	if err = db.SaveBookmark(ctx, profile.ID, bookmark); err != nil {
+		t.Fatal(err)
+	}
+	if entry, ok := account.NewBookmarkHistory("user", nil, &bookmark, time.Now()); !ok {
+		t.Fatal("expected bookmark history")
+	} else if err = db.AddBookmarkHistory(ctx, profile.ID, entry); err != nil {
+		t.Fatal(err)
+	}
+
+	// a new sponsor is recorded as a change
+	updated := bill
+	updated.Sponsors = append(updated.Sponsors, legislature.Member{Slug: "b"})
+	if _, err = db.SaveBill(ctx, updated); err != nil {
+		t.Fatal(err)
+	}
+	changes, err := db.GetChanges(ctx, bill.Body, bill.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes.Sponsors) != 1 || changes.Sponsors[0].Member.Slug != "b" {
+		t.Fatalf("unexpected changes %#v", changes)
+	}
+
+	if err = db.RenameProfile(ctx, profile.ID, "renamed-profile", "user"); err != nil {
+		t.Fatal(err)
+	}
+	if r, err := db.GetRedirect(ctx, profile.ID); err != nil || r == nil || r.To != "renamed-profile" {
+		t.Fatalf("unexpected redirect %#v %v", r, err)
+	}
+	bookmarks, err := db.GetProfileBookmarks(ctx, "renamed-profile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bookmarks) != 1 || bookmarks[0].UID != "user" || len(bookmarks[0].Legislation.Sponsors) != 2 {
+		t.Fatalf("unexpected bookmarks %#v", bookmarks)
+	}
+	if history, err := db.GetProfileBookmarkHistory(ctx, "renamed-profile"); err != nil || len(history) != 1 {
+		t.Fatalf("unexpected history %#v %v", history, err)
+	}
+	if bookmarks, err := db.GetProfileBookmarks(ctx, profile.ID); err != nil || len(bookmarks) != 0 {
+		t.Fatalf("expected no bookmarks for the old profile got %#v %v", bookmarks, err)
+	}
+	if history, err := db.GetProfileBookmarkHistory(ctx, profile.ID); err != nil || len(history) != 0 {
+		t.Fatalf("expected no history for the old profile got %#v %v", history, err)
+	}
+	pc, err := db.GetProfileChanges(ctx, "renamed-profile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pc) != 1 || len(pc[0].Changes.Sponsors) != 1 {
+		t.Fatalf("unexpected profile changes %#v", pc)
+	}
+
+	if err = db.RemoveSponsorChanges(ctx, legislature.GlobalID{BodyID: bill.Body, LegislationID: bill.ID}, changes.Sponsors); err != nil {
+		t.Fatal(err)
+	}
+	if changes, err = db.GetChanges(ctx, bill.Body, bill.ID); err != nil || len(changes.Sponsors) != 0 {
+		t.Fatalf("unexpected changes %#v %v", changes, err)
+	}
+	if _, err = db.GetBill(ctx, "nyc", "missing"); !IsNotFound(err) {
+		t.Fatalf("expected NotFound got %v", err)
+	}
+
+	if err = db.DeleteProfile(ctx, "renamed-profile"); err != nil {
+		t.Fatal(err)
+	}
+	if p, err := db.GetProfile(ctx, "renamed-profile"); err != nil || p != nil {
+		t.Fatalf("expected deleted profile got %#v %v", p, err)
+	}
+	if bookmarks, err = db.GetProfileBookmarks(ctx, "renamed-profile"); err != nil || len(bookmarks) != 0 {
+		t.Fatalf("expected deleted bookmarks got %#v %v", bookmarks, err)
+	}
+}
+
+func TestBoltAPITokens(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewBolt(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	secret, token, err := account.NewAPIToken("user", "test", []account.Scope{account.ProfilesReadScope}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = db.CreateAPIToken(ctx, token); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetAPIToken(ctx, account.HashAPIToken(secret))
+	if err != nil || got == nil || got.ID != token.ID || !got.HasScope(account.ProfilesReadScope) {
+		t.Fatalf("unexpected token %#v %v", got, err)
+	}
+	if got, err = db.GetAPIToken(ctx, account.HashAPIToken("ls_missing")); err != nil || got != nil {
+		t.Fatalf("expected missing token got %#v %v", got, err)
+	}
+	if tokens, err := db.GetAPITokens(ctx, "user"); err != nil || len(tokens) != 1 {
+		t.Fatalf("got %d tokens err %v", len(tokens), err)
+	}
+	if tokens, err := db.GetAPITokens(ctx, "other"); err != nil || len(tokens) != 0 {
+		t.Fatalf("got %d tokens err %v", len(tokens), err)
+	}
+	if err = db.DeleteAPIToken(ctx, token.Hash); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = db.GetAPIToken(ctx, token.Hash); err != nil || got != nil {
+		t.Fatalf("expected deleted token got %#v %v", got, err)
+	}
+}
+
+func TestBoltBookmarkHistory(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewBolt(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	now := time.Now().UTC()
+	a := account.Bookmark{BodyID: "nyc", LegislationID: "1"}
+	b := a
+	b.SetPosition(account.OpposePosition)
+	other := account.Bookmark{BodyID: "nyc", LegislationID: "2"}
+	for i, h := range []struct{ old, new *account.Bookmark }{{nil, &a}, {&a, &b}, {nil, &other}, {&b, nil}} {
+		entry, ok := account.NewBookmarkHistory("user", h.old, h.new, now)
+		if !ok {
+			t.Fatalf("expected a change %d", i)
+		}
+		if err = db.AddBookmarkHistory(ctx, "profile", entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	history, err := db.GetBookmarkHistory(ctx, "profile", "nyc", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var actions []account.HistoryAction
+	for _, h := range history {
+		actions = append(actions, h.Action)
+	}
+	if fmt.Sprint(actions) != "[deleted updated created]" {
+		t.Errorf("unexpected history %v", actions)
+	}
+	if !history[1].PositionChanged() {
+		t.Errorf("expected a position change %#v", history[1])
+	}
+
+	if err = db.DeleteProfile(ctx, "profile"); err != nil {
+		t.Fatal(err)
+	}
+	if history, err = db.GetProfileBookmarkHistory(ctx, "profile"); err != nil || len(history) != 0 {
+		t.Errorf("expected history to be deleted got %d %v", len(history), err)
+	}
+}
+
+func TestBoltSaveBillSponsorHistory(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewBolt(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	introduced := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)
+	bill := legislature.Legislation{
+		Body:           "us-house",
+		ID:             "119-HR1",
+		IntroducedDate: introduced,
+		Sponsors: []legislature.Member{
+			{Slug: "a", Role: legislature.PrimeSponsor, SponsorDate: introduced},
+			{Slug: "b", Role: legislature.Cosponsor, SponsorDate: introduced.AddDate(0, 1, 0)},
+		},
+	}
+	if _, err = db.SaveBill(ctx, bill); err != nil {
+		t.Fatal(err)
+	}
+	changes, err := db.GetChanges(ctx, bill.Body, bill.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes.Sponsors) != 1 || changes.Sponsors[0].Member.Slug != "b" || !changes.Sponsors[0].Date.Equal(introduced.AddDate(0, 1, 0)) {
+		t.Fatalf("unexpected changes %#v", changes)
+	}
+}
+
+func TestBoltGetStaleBills(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewBolt(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// a session that ended yesterday but is still in its lame duck period
+	now := time.Now().UTC()
+	session := legislature.Session{StartYear: now.Year() - 2, EndYear: now.Year() - 1}
+	legislature.RegisterCalendar("lame-duck", &legislature.Calendar{
+		LameDuck: 30 * 24 * time.Hour,
+		Terms:    []legislature.Term{{Session: session, Start: now.AddDate(-2, 0, 0), End: now.Add(-24 * time.Hour)}},
+	})
+	defer legislature.RegisterCalendar("lame-duck", nil)
+
+	stale := now.Add(-24 * time.Hour)
+	bills := []legislature.Legislation{
+		{Body: "lame-duck", ID: "1", Session: session, LastChecked: stale},
+		{Body: "lame-duck", ID: "2", Session: session, LastChecked: now},
+		{Body: "nyc", ID: "3", Session: legislature.Session{StartYear: now.Year() - 3, EndYear: now.Year() - 1}, LastChecked: stale},
+		{Body: "nyc", ID: "4", Session: legislature.Session{StartYear: now.Year(), EndYear: now.Year() + 1}, LastChecked: stale},
+		{Body: "unavailable", ID: "5", Session: legislature.Session{StartYear: now.Year(), EndYear: now.Year() + 1}, LastChecked: stale.Add(-time.Hour)},
+	}
+	err = db.db.Update(func(tx *bolt.Tx) error {
+		for _, b := range bills {
+			if err := put(tx, b, string(b.ID), "bills", string(b.Body)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetStaleBills(ctx, 10, []legislature.BodyID{"lame-duck", "nyc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []legislature.LegislationID
+	for _, b := range got {
+		ids = append(ids, b.ID)
+	}
+	if !slices.Equal(ids, []legislature.LegislationID{"1", "4"}) {
+		t.Errorf("got stale bills %v expected [1 4]", ids)
+	}
+}
+
+func TestIsFirestore(t *testing.T) {
+	for dsn, expected := range map[string]bool{
+		"":                 true,
+		"firestore":        true,
+		"firestore:my-app": true,
+		"bolt:/tmp/x.db":   false,
+	} {
+		if got := IsFirestore(dsn); got != expected {
+			t.Errorf("%q got %v expected %v", dsn, got, expected)
+		}
+	}
+}
@@ -3,6 +3,8 @@ package datastore
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 	"time"
 
 	"cloud.google.com/go/firestore"
@@ -41,8 +43,36 @@ func (db *Datastore) GetProfile(ctx context.Context, ID account.ProfileID) (*acc
 }
 
 func (db *Datastore) GetProfiles(ctx context.Context, UID account.UID) ([]account.Profile, error) {
-	query := db.firestore.Collection("profiles").Where("UID", "==", string(UID)).OrderBy("Name", firestore.Asc).Limit(100)
-	// ref := db.firestore.Collection(fmt.Sprintf("users/%s/profiles", UID))
+	profiles := db.firestore.Collection("profiles")
+	// profiles created by UID and profiles UID was invited to
+	out, err := db.queryProfiles(ctx, profiles.Where("UID", "==", string(UID)).OrderBy("Name", firestore.Asc).Limit(100))
+	if err != nil {
+		return nil, err
+	}
+	member, err := db.queryProfiles(ctx, profiles.Where("MemberUIDs", "array-contains", string(UID)).Limit(100))
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range member {
+		if !slices.ContainsFunc(out, func(pp account.Profile) bool { return pp.ID == p.ID }) {
+			out = append(out, p)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	if len(out) > 100 {
+		out = out[:100]
+	}
+	return out, nil
+}
+
+func (db *Datastore) GetInvitations(ctx context.Context, email string) ([]account.Profile, error) {
+	if email == "" {
+		return nil, nil
+	}
+	return db.queryProfiles(ctx, db.firestore.Collection("profiles").Where("InvitedEmails", "array-contains", email).Limit(100))
+}
+
+func (db *Datastore) queryProfiles(ctx context.Context, query firestore.Query) ([]account.Profile, error) {
 	iter := query.Documents(ctx)
 	defer iter.Stop()
 	var out []account.Profile
@@ -64,17 +94,19 @@ func (db *Datastore) GetProfiles(ctx context.Context, UID account.UID) ([]accoun
 	return out, nil
 }
 
-// GetStaleBills gets bills in an active session that have not been checked recently
-func (db *Datastore) GetStaleBills(ctx context.Context, limit int) ([]legislature.Legislation, error) {
+// GetStaleBills gets bills for bodies in an active session that have not been checked recently
+func (db *Datastore) GetStaleBills(ctx context.Context, limit int, bodies []legislature.BodyID) ([]legislature.Legislation, error) {
 	target := time.Hour * 6
 	now := time.Now().UTC()
 	cutoff := now.Add(-1 * target)
+	// a session ending last year can still be active (i.e. a lame duck period in January)
+	// so those are selected and then checked against the body's calendar
 	iter := db.firestore.CollectionGroup("bills").Where(
 		"LastChecked", "<", cutoff).Where(
-		"Session.EndYear", ">=", now.Year()).Limit(limit).Documents(ctx)
+		"Session.EndYear", ">=", now.Year()-1).Documents(ctx)
 	defer iter.Stop()
 	var out []legislature.Legislation
-	for {
+	for len(out) < limit {
 		doc, err := iter.Next()
 		if err == iterator.Done {
 			break
@@ -87,6 +119,9 @@ func (db *Datastore) GetStaleBills(ctx context.Context, limit int) ([]legislatur
 		if err != nil {
 			return nil, err
 		}
+		if !o.ActiveAt(now) || !slices.Contains(bodies, o.Body) {
+			continue
+		}
 		out = append(out, o)
 	}
 	return out, nil
@@ -175,10 +210,135 @@ func (db *Datastore) RenameProfile(ctx context.Context, old, newID account.Profi
 			return err
 		}
 	}
-	_, err = db.firestore.Collection("profiles").Doc(string(old)).Delete(ctx)
+	history, err := db.GetProfileBookmarkHistory(ctx, old)
+	if err != nil {
+		return err
+	}
+	for _, h := range history {
+		if err = db.AddBookmarkHistory(ctx, newID, h); err != nil {
+			return err
+		}
+	}
+	// remove the old profile along with its bookmarks and bookmark history
+	return db.DeleteProfile(ctx, old)
+}
+
+// DeleteProfile deletes a profile, its bookmarks and bookmark history
+func (db *Datastore) DeleteProfile(ctx context.Context, ID account.ProfileID) error {
+	profile := db.firestore.Collection("profiles").Doc(string(ID))
+	for _, collection := range []string{"bookmarks", "bookmark_history"} {
+		iter := profile.Collection(collection).DocumentRefs(ctx)
+		for {
+			doc, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			if _, err = doc.Delete(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := profile.Delete(ctx)
+	return err
+}
+
+// API tokens are stored by their hash
+func (db *Datastore) CreateAPIToken(ctx context.Context, t account.APIToken) error {
+	_, err := db.firestore.Collection("api_tokens").Doc(t.Hash).Create(ctx, t)
+	return err
+}
+
+func (db *Datastore) UpdateAPIToken(ctx context.Context, t account.APIToken) error {
+	_, err := db.firestore.Collection("api_tokens").Doc(t.Hash).Set(ctx, t)
+	return err
+}
+
+func (db *Datastore) GetAPIToken(ctx context.Context, hash string) (*account.APIToken, error) {
+	if hash == "" {
+		return nil, nil
+	}
+	dsnap, err := db.firestore.Collection("api_tokens").Doc(hash).Get(ctx)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !dsnap.Exists() {
+		return nil, nil
+	}
+	var t account.APIToken
+	err = dsnap.DataTo(&t)
+	return &t, err
+}
+
+func (db *Datastore) GetAPITokens(ctx context.Context, UID account.UID) ([]account.APIToken, error) {
+	iter := db.firestore.Collection("api_tokens").Where("UID", "==", string(UID)).Limit(100).Documents(ctx)
+	defer iter.Stop()
+	var out []account.APIToken
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var t account.APIToken
+		if err = doc.DataTo(&t); err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Created.After(out[j].Created) })
+	return out, nil
+}
+
+func (db *Datastore) DeleteAPIToken(ctx context.Context, hash string) error {
+	_, err := db.firestore.Collection("api_tokens").Doc(hash).Delete(ctx)
+	return err
+}
+
+// AddBookmarkHistory stores history in profiles/{profile}/bookmark_history with a generated ID
+func (db *Datastore) AddBookmarkHistory(ctx context.Context, p account.ProfileID, h account.BookmarkHistory) error {
+	_, err := db.firestore.Collection("profiles").Doc(string(p)).Collection("bookmark_history").NewDoc().Create(ctx, h)
 	return err
 }
 
+func (db *Datastore) GetBookmarkHistory(ctx context.Context, p account.ProfileID, body legislature.BodyID, id legislature.LegislationID) ([]account.BookmarkHistory, error) {
+	q := db.firestore.Collection("profiles").Doc(string(p)).Collection("bookmark_history").Where("BodyID", "==", string(body)).Where("LegislationID", "==", string(id))
+	return db.bookmarkHistory(ctx, q)
+}
+
+func (db *Datastore) GetProfileBookmarkHistory(ctx context.Context, p account.ProfileID) ([]account.BookmarkHistory, error) {
+	return db.bookmarkHistory(ctx, db.firestore.Collection("profiles").Doc(string(p)).Collection("bookmark_history").Query)
+}
+
+func (db *Datastore) bookmarkHistory(ctx context.Context, q firestore.Query) ([]account.BookmarkHistory, error) {
+	iter := q.Documents(ctx)
+	defer iter.Stop()
+	var out []account.BookmarkHistory
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var h account.BookmarkHistory
+		if err = doc.DataTo(&h); err != nil {
+			return nil, err
+		}
+		out = append(out, h)
+	}
+	sort.Stable(account.SortedBookmarkHistory(out))
+	return out, nil
+}
+
 // CreateRedirect creates a redirect from one profile URL to another
 func (db *Datastore) CreateRedirect(ctx context.Context, from, to account.ProfileID, UID account.UID) error {
 	now := time.Now().UTC()
@@ -264,20 +424,22 @@ func (app *Datastore) UpdateBill(ctx context.Context, a, b legislature.Legislati
 		staleSameAs = true
 	}
 
-	changes := legislature.CalculateSponsorChanges(a, b)
-	if len(changes) > 0 {
+	changes := legislature.CalculateChanges(a, b)
+	if !changes.Empty() {
 		log.Debugf("changes %s %s %#v", b.Body, b.ID, changes)
-		changesArray := make([]interface{}, len(changes))
-		for i := range changes {
-			changesArray[i] = changes[i]
+		var updates []firestore.Update
+		if len(changes.Sponsors) > 0 {
+			updates = append(updates, firestore.Update{Path: "Sponsors", Value: firestore.ArrayUnion(toAny(changes.Sponsors)...)})
+		}
+		if len(changes.Status) > 0 {
+			updates = append(updates, firestore.Update{Path: "Status", Value: firestore.ArrayUnion(toAny(changes.Status)...)})
 		}
-		_, err = app.firestore.Collection("bodies").Doc(string(b.Body)).Collection("changes").Doc(string(b.ID)).Update(ctx, []firestore.Update{
-			{Path: "Sponsors", Value: firestore.ArrayUnion(changesArray...)},
-		})
+		if len(changes.Amendments) > 0 {
+			updates = append(updates, firestore.Update{Path: "Amendments", Value: firestore.ArrayUnion(toAny(changes.Amendments)...)})
+		}
+		_, err = app.firestore.Collection("bodies").Doc(string(b.Body)).Collection("changes").Doc(string(b.ID)).Update(ctx, updates)
 		if err != nil && IsNotFound(err) {
-			_, err = app.firestore.Collection("bodies").Doc(string(b.Body)).Collection("changes").Doc(string(b.ID)).Set(ctx, legislature.Changes{
-				Sponsors: changes,
-			})
+			_, err = app.firestore.Collection("bodies").Doc(string(b.Body)).Collection("changes").Doc(string(b.ID)).Set(ctx, changes)
 			if err != nil {
 				return
 			}
@@ -291,7 +453,17 @@ func (app *Datastore) UpdateBill(ctx context.Context, a, b legislature.Legislati
 	return
 }
 
-// SaveBill creates a bill in the database or updates an existing bill with a diff of SponsorChanges
+// toAny converts a slice for use with firestore.ArrayUnion and firestore.ArrayRemove
+func toAny[T any](v []T) []interface{} {
+	o := make([]interface{}, len(v))
+	for i := range v {
+		o[i] = v[i]
+	}
+	return o
+}
+
+// SaveBill creates a bill in the database or updates an existing bill with a diff of SponsorChanges.
+// New bills record the sponsor history from upstream sponsor dates (see legislature.SponsorHistory)
 //
 // If SameAs is set and it doesn't exist in the database `staleSameAs` will be set to true
 func (db *Datastore) SaveBill(ctx context.Context, b legislature.Legislation) (staleSameAs bool, err error) {
@@ -305,7 +477,19 @@ func (db *Datastore) SaveBill(ctx context.Context, b legislature.Legislation) (s
 			return
 		}
 		staleSameAs, err = db.UpdateBill(ctx, *a, b)
-	} else if b.SameAs != "" { // inserted a new bill
+		return
+	} else if err != nil {
+		return
+	}
+
+	// inserted a new bill; back-fill sponsor history from upstream dates
+	if history := legislature.SponsorHistory(b); len(history) > 0 {
+		_, err = db.firestore.Collection("bodies").Doc(string(b.Body)).Collection("changes").Doc(string(b.ID)).Set(ctx, legislature.Changes{Sponsors: history})
+		if err != nil {
+			return
+		}
+	}
+	if b.SameAs != "" {
 		// check if sameAs exists
 		sameAsBody := resolvers.Bodies[b.Body].Bicameral
 		_, err = db.firestore.Collection("bodies").Doc(string(sameAsBody)).Collection("bills").Doc(string(b.SameAs)).Get(ctx)
@@ -469,3 +653,43 @@ func (db *Datastore) GetChanges(ctx context.Context, body legislature.BodyID, id
 	err = dsnap.DataTo(&r)
 	return r, err
 }
+
+// GetAllChanges iterates over the changes recorded for all bills
+func (db *Datastore) GetAllChanges(ctx context.Context, callback func(id legislature.GlobalID, c legislature.Changes) error) error {
+	iter := db.firestore.CollectionGroup("changes").Documents(ctx)
+	defer iter.Stop()
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		var c legislature.Changes
+		err = doc.DataTo(&c)
+		if err != nil {
+			return err
+		}
+		id := legislature.GlobalID{
+			BodyID:        legislature.BodyID(doc.Ref.Parent.Parent.ID),
+			LegislationID: legislature.LegislationID(doc.Ref.ID),
+		}
+		err = callback(id, c)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveSponsorChanges removes specific sponsor changes recorded for a bill
+func (db *Datastore) RemoveSponsorChanges(ctx context.Context, id legislature.GlobalID, remove []legislature.SponsorChange) error {
+	if len(remove) == 0 {
+		return nil
+	}
+	_, err := db.firestore.Collection("bodies").Doc(string(id.BodyID)).Collection("changes").Doc(string(id.LegislationID)).Update(ctx, []firestore.Update{
+		{Path: "Sponsors", Value: firestore.ArrayRemove(toAny(remove)...)},
+	})
+	return err
+}
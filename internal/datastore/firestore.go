@@ -4,24 +4,24 @@ import (
 	"context"
 
 	"cloud.google.com/go/firestore"
-	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-func NewClient(ctx context.Context) *firestore.Client {
-	// Sets your Google Cloud Platform project ID.
-	projectID := "legislation-support"
+// DefaultProjectID is the Google Cloud project used when one is not specified
+const DefaultProjectID = "legislation-support"
 
-	client, err := firestore.NewClient(ctx, projectID)
-	if err != nil {
-		log.Fatalf("Failed to create client: %v", err)
+func NewClient(ctx context.Context, projectID string) (*firestore.Client, error) {
+	if projectID == "" {
+		projectID = DefaultProjectID
 	}
 	// Close client when done with
 	// defer client.Close()
-	return client
+	return firestore.NewClient(ctx, projectID)
 }
 
+// IsAlreadyExists and IsNotFound work with errors from any Store implementation
+// as they all use grpc status codes
 func IsAlreadyExists(err error) bool {
 	return status.Code(err) == codes.AlreadyExists
 }
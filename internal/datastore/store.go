@@ -0,0 +1,87 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jehiah/legislation.support/internal/account"
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+// Store is the persistence layer for profiles, bookmarks, bills and their changes.
+//
+// Datastore (Google Cloud Firestore) and BoltStore (a local bbolt file) implement Store
+type Store interface {
+	GetProfile(ctx context.Context, ID account.ProfileID) (*account.Profile, error)
+	GetProfiles(ctx context.Context, UID account.UID) ([]account.Profile, error) // profiles UID is a member of
+	GetInvitations(ctx context.Context, email string) ([]account.Profile, error) // profiles with an invitation for email
+	CreateProfile(ctx context.Context, p account.Profile) error
+	UpdateProfile(ctx context.Context, p account.Profile) error
+	RenameProfile(ctx context.Context, old, newID account.ProfileID, user account.UID) error
+	DeleteProfile(ctx context.Context, ID account.ProfileID) error // and its bookmarks and bookmark history
+
+	CreateAPIToken(ctx context.Context, t account.APIToken) error
+	UpdateAPIToken(ctx context.Context, t account.APIToken) error
+	GetAPIToken(ctx context.Context, hash string) (*account.APIToken, error)
+	GetAPITokens(ctx context.Context, UID account.UID) ([]account.APIToken, error)
+	DeleteAPIToken(ctx context.Context, hash string) error
+
+	CreateRedirect(ctx context.Context, from, to account.ProfileID, UID account.UID) error
+	GetRedirect(ctx context.Context, from account.ProfileID) (*account.ProfileRedirect, error)
+
+	SaveBookmark(ctx context.Context, p account.ProfileID, b account.Bookmark) error
+	UpdateBookmark(ctx context.Context, p account.ProfileID, b account.Bookmark) error
+	DeleteBookmark(ctx context.Context, p account.ProfileID, b legislature.BodyID, l legislature.LegislationID) error
+	GetBookmark(ctx context.Context, p account.ProfileID, key string) (*account.Bookmark, error)
+	GetProfileBookmarks(ctx context.Context, profileID account.ProfileID) (account.Bookmarks, error)
+
+	// bookmark history is append only
+	AddBookmarkHistory(ctx context.Context, p account.ProfileID, h account.BookmarkHistory) error
+	GetBookmarkHistory(ctx context.Context, p account.ProfileID, b legislature.BodyID, l legislature.LegislationID) ([]account.BookmarkHistory, error) // newest first
+	GetProfileBookmarkHistory(ctx context.Context, p account.ProfileID) ([]account.BookmarkHistory, error)                                             // newest first
+
+	SaveBill(ctx context.Context, b legislature.Legislation) (staleSameAs bool, err error)
+	UpdateBill(ctx context.Context, a, b legislature.Legislation) (staleSameAs bool, err error)
+	GetBill(ctx context.Context, body legislature.BodyID, id legislature.LegislationID) (*legislature.Legislation, error)
+	GetStaleBills(ctx context.Context, limit int, bodies []legislature.BodyID) ([]legislature.Legislation, error)
+	GetRecentBills(ctx context.Context, limit int) ([]legislature.Legislation, error)
+	GetAllBills(ctx context.Context, callback func(l legislature.Legislation) error) error
+
+	GetProfileChanges(ctx context.Context, profileID account.ProfileID) ([]BookmarkChanges, error)
+	GetChanges(ctx context.Context, body legislature.BodyID, id legislature.LegislationID) (legislature.Changes, error)
+	GetAllChanges(ctx context.Context, callback func(id legislature.GlobalID, c legislature.Changes) error) error
+	RemoveSponsorChanges(ctx context.Context, id legislature.GlobalID, remove []legislature.SponsorChange) error
+}
+
+// DSNUsage describes the accepted values for Open; it's suitable for flag help text
+const DSNUsage = "datastore: firestore, firestore:$project_id or bolt:$path"
+
+// IsFirestore is true when dsn (see Open) is a Firestore datastore
+func IsFirestore(dsn string) bool {
+	kind, _, _ := strings.Cut(dsn, ":")
+	return kind == "" || kind == "firestore"
+}
+
+// Open returns the Store described by dsn
+//
+//	firestore             Firestore in the default project
+//	firestore:$project_id Firestore in the specified project
+//	bolt:$path            a local bbolt database file (created if needed)
+func Open(ctx context.Context, dsn string) (Store, error) {
+	kind, arg, _ := strings.Cut(dsn, ":")
+	switch kind {
+	case "", "firestore":
+		client, err := NewClient(ctx, arg)
+		if err != nil {
+			return nil, err
+		}
+		return New(client), nil
+	case "bolt":
+		if arg == "" {
+			return nil, fmt.Errorf("missing path for bolt datastore")
+		}
+		return NewBolt(arg)
+	}
+	return nil, fmt.Errorf("unknown datastore %q", dsn)
+}
@@ -0,0 +1,102 @@
+package legislature
+
+import (
+	"strings"
+	"time"
+)
+
+// ActionKind is a normalized category for a step in the legislative process
+type ActionKind string
+
+const (
+	IntroducedAction  ActionKind = "Introduced"
+	ReferredAction    ActionKind = "Referred"
+	CommitteeAction   ActionKind = "Committee" // hearings, held, laid over
+	ReportedAction    ActionKind = "Reported"  // out of committee
+	AmendedAction     ActionKind = "Amended"
+	FloorAction       ActionKind = "Floor" // calendars, readings
+	PassedAction      ActionKind = "Passed"
+	FailedAction      ActionKind = "Failed"
+	SubstitutedAction ActionKind = "Substituted"
+	DeliveredAction   ActionKind = "Delivered" // to the executive
+	SignedAction      ActionKind = "Signed"
+	VetoedAction      ActionKind = "Vetoed"
+	OverriddenAction  ActionKind = "Veto Overridden"
+	WithdrawnAction   ActionKind = "Withdrawn"
+	FiledAction       ActionKind = "Filed" // i.e. end of session
+	OtherAction       ActionKind = ""
+)
+
+// IsStatusChange is true for the kinds of actions that advance (or end) a bill
+func (k ActionKind) IsStatusChange() bool {
+	switch k {
+	case ReportedAction, PassedAction, FailedAction, SubstitutedAction, DeliveredAction,
+		SignedAction, VetoedAction, OverriddenAction, WithdrawnAction, FiledAction:
+		return true
+	}
+	return false
+}
+
+// Action is one entry in the history of a bill
+type Action struct {
+	Date      time.Time
+	Chamber   string `firestore:",omitempty" json:",omitempty"` // i.e. "Senate", "Assembly"
+	Committee string `firestore:",omitempty" json:",omitempty"`
+	Text      string
+	Kind      ActionKind `firestore:",omitempty" json:",omitempty"`
+}
+
+// ActionKindFromText categorizes the free text description of an action
+// using wording common to the NY Senate, NYC Council and congress.gov
+func ActionKindFromText(text string) ActionKind {
+	t := strings.ToLower(text)
+	has := func(s ...string) bool {
+		for _, ss := range s {
+			if strings.Contains(t, ss) {
+				return true
+			}
+		}
+		return false
+	}
+	switch {
+	case has("overridden", "override"):
+		return OverriddenAction
+	case has("vetoed", "veto message"):
+		return VetoedAction
+	case strings.HasPrefix(t, "signed"), has("signed into law", "signed by president", "became public law", "became law"):
+		return SignedAction
+	case has("delivered to governor", "sent to mayor", "presented to president"):
+		return DeliveredAction
+	case strings.HasPrefix(t, "substituted"):
+		return SubstitutedAction
+	case strings.HasPrefix(t, "introduced"):
+		return IntroducedAction
+	case has("reported", "approved by committee"):
+		return ReportedAction
+	case strings.HasPrefix(t, "print number"), has("amend"):
+		return AmendedAction
+	case has("referred to", "committed to", "recommitted"):
+		return ReferredAction
+	case has("passed", "agreed to", "approved by council", "adopted"):
+		return PassedAction
+	case has("failed", "defeated", "lost"):
+		return FailedAction
+	case has("withdrawn"):
+		return WithdrawnAction
+	case has("filed"):
+		return FiledAction
+	case has("hearing", "laid over", "held for consideration", "held in committee"):
+		return CommitteeAction
+	case has("calendar", "third reading", "cal."):
+		return FloorAction
+	}
+	return OtherAction
+}
+
+// LatestAction returns the most recent action (if any)
+func (l Legislation) LatestAction() *Action {
+	if len(l.Actions) == 0 {
+		return nil
+	}
+	return &l.Actions[len(l.Actions)-1]
+}
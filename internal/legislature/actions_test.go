@@ -0,0 +1,45 @@
+package legislature
+
+import (
+	"testing"
+)
+
+func TestActionKindFromText(t *testing.T) {
+	tests := []struct {
+		text string
+		want ActionKind
+	}{
+		// NY Senate
+		{"REFERRED TO HEALTH", ReferredAction},
+		{"AMEND AND RECOMMIT TO HEALTH", AmendedAction},
+		{"PRINT NUMBER 1234A", AmendedAction},
+		{"REPORTED AND COMMITTED TO FINANCE", ReportedAction},
+		{"1ST REPORT CAL.123", FloorAction},
+		{"PASSED SENATE", PassedAction},
+		{"SUBSTITUTED FOR A1234", SubstitutedAction},
+		{"DELIVERED TO GOVERNOR", DeliveredAction},
+		{"SIGNED CHAP.123", SignedAction},
+		{"VETOED MEMO.12", VetoedAction},
+		{"DELIVERED TO ASSEMBLY", OtherAction},
+		// NYC Council
+		{"Introduced by Council", IntroducedAction},
+		{"Referred to Comm by Council", ReferredAction},
+		{"Hearing Held by Committee", CommitteeAction},
+		{"Approved by Committee", ReportedAction},
+		{"Approved by Council", PassedAction},
+		{"Sent to Mayor by Council", DeliveredAction},
+		{"Signed Into Law by Mayor", SignedAction},
+		{"Overridden by Council", OverriddenAction},
+		{"Filed (End of Session)", FiledAction},
+		// congress.gov
+		{"Became Public Law No: 118-12.", SignedAction},
+		{"Failed of passage in House by Yea-Nay Vote. 200 - 220.", FailedAction},
+	}
+	for _, tc := range tests {
+		t.Run(tc.text, func(t *testing.T) {
+			if got := ActionKindFromText(tc.text); got != tc.want {
+				t.Errorf("got %q want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package legislature
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Amendment is a published version of a bill (i.e. S1234 and the amended S1234A)
+type Amendment struct {
+	Version     string // "" for the original print
+	PrintNo     string // i.e. "S1234A"
+	PublishDate time.Time
+	LawSection  string `firestore:",omitempty" json:",omitempty"`
+	Text        string `firestore:"-" json:"-"` // full text (line numbers removed); not stored
+}
+
+// AmendmentChange is the publication of a new version of a bill
+type AmendmentChange struct {
+	Date        time.Time
+	Version     string
+	PrintNo     string
+	FromPrintNo string `firestore:",omitempty" json:",omitempty"`
+	Diff        string `firestore:",omitempty" json:",omitempty"` // see TextDiff
+}
+
+// LatestAmendment returns the most recent version (if any)
+func (l Legislation) LatestAmendment() *Amendment {
+	if len(l.Amendments) == 0 {
+		return nil
+	}
+	return &l.Amendments[len(l.Amendments)-1]
+}
+
+// CalculateAmendmentChanges returns an AmendmentChange for each version in b that is not in a
+// with a diff from the preceding version.
+//
+// Bills stored before amendments were recorded (a has no Amendments) have no changes
+func CalculateAmendmentChanges(a, b Legislation) []AmendmentChange {
+	if len(a.Amendments) == 0 {
+		return nil
+	}
+	have := make(map[string]bool, len(a.Amendments))
+	for _, am := range a.Amendments {
+		have[am.Version] = true
+	}
+	var changes []AmendmentChange
+	for i, am := range b.Amendments {
+		if have[am.Version] || i == 0 {
+			continue
+		}
+		prev := b.Amendments[i-1]
+		date := am.PublishDate
+		if date.IsZero() {
+			date = firstDate(b.LastModified, time.Now().UTC())
+		}
+		changes = append(changes, AmendmentChange{
+			Date:        date,
+			Version:     am.Version,
+			PrintNo:     am.PrintNo,
+			FromPrintNo: prev.PrintNo,
+			Diff:        TextDiff(prev.Text, am.Text),
+		})
+	}
+	return changes
+}
+
+// maxDiffLines limits the size of the comparison; maxDiffBytes limits the size of the output
+const (
+	maxDiffLines = 2000
+	maxDiffBytes = 16 * 1024
+)
+
+// TextDiff returns a line diff of a and b with removed lines prefixed with "- " and added lines with "+ ".
+//
+// Lines are compared after collapsing whitespace. The output is truncated for long diffs
+func TextDiff(a, b string) string {
+	al, bl := diffLines(a), diffLines(b)
+	// skip the common prefix and suffix; amendments usually change a small part of a bill
+	for len(al) > 0 && len(bl) > 0 && al[0] == bl[0] {
+		al, bl = al[1:], bl[1:]
+	}
+	for len(al) > 0 && len(bl) > 0 && al[len(al)-1] == bl[len(bl)-1] {
+		al, bl = al[:len(al)-1], bl[:len(bl)-1]
+	}
+	if len(al) == 0 && len(bl) == 0 {
+		return ""
+	}
+	if len(al) > maxDiffLines || len(bl) > maxDiffLines {
+		return fmt.Sprintf("(too many changes to compare: %d lines removed or changed, %d lines added or changed)", len(al), len(bl))
+	}
+
+	// longest common subsequence
+	lcs := make([][]int32, len(al)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(bl)+1)
+	}
+	for i := len(al) - 1; i >= 0; i-- {
+		for j := len(bl) - 1; j >= 0; j-- {
+			if al[i] == bl[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out strings.Builder
+	write := func(prefix, line string) bool {
+		if out.Len()+len(line) > maxDiffBytes {
+			out.WriteString("…\n")
+			return false
+		}
+		out.WriteString(prefix + line + "\n")
+		return true
+	}
+	i, j := 0, 0
+	for i < len(al) || j < len(bl) {
+		switch {
+		case i < len(al) && j < len(bl) && al[i] == bl[j]:
+			i++
+			j++
+			continue
+		case i < len(al) && (j == len(bl) || lcs[i+1][j] >= lcs[i][j+1]):
+			if !write("- ", al[i]) {
+				return out.String()
+			}
+			i++
+		default:
+			if !write("+ ", bl[j]) {
+				return out.String()
+			}
+			j++
+		}
+	}
+	return out.String()
+}
+
+func diffLines(s string) []string {
+	var out []string
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.Join(strings.Fields(line), " ")
+		if line != "" {
+			out = append(out, line)
+		}
+	}
+	return out
+}
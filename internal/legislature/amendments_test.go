@@ -0,0 +1,62 @@
+package legislature
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTextDiff(t *testing.T) {
+	type testCase struct {
+		name     string
+		a, b     string
+		expected string
+	}
+	tests := []testCase{
+		{"same", "a\nb\nc", "a\nb\nc", ""},
+		{"whitespace", "a\nb  c", "a \n b c\n\n", ""},
+		{"added", "a\nc", "a\nb\nc", "+ b\n"},
+		{"removed", "a\nb\nc", "a\nc", "- b\n"},
+		{"changed", "a\nb\nc\nd", "a\nB\nc\nd", "- b\n+ B\n"},
+		{"multiple", "a\nb\nc\nd\ne", "a\nB\nc\nd\nE", "- b\n+ B\n- e\n+ E\n"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := TextDiff(tc.a, tc.b); got != tc.expected {
+				t.Errorf("got %q expected %q", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCalculateAmendmentChanges(t *testing.T) {
+	d1 := time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC)
+	d2 := time.Date(2023, 3, 10, 0, 0, 0, 0, time.UTC)
+	original := Amendment{Version: "", PrintNo: "S1234", PublishDate: d1, Text: "a\nb"}
+	amended := Amendment{Version: "A", PrintNo: "S1234A", PublishDate: d2, Text: "a\nB"}
+
+	type testCase struct {
+		name     string
+		a, b     Legislation
+		expected []AmendmentChange
+	}
+	tests := []testCase{
+		{"not previously recorded", Legislation{}, Legislation{Amendments: []Amendment{original, amended}}, nil},
+		{"unchanged", Legislation{Amendments: []Amendment{original}}, Legislation{Amendments: []Amendment{original}}, nil},
+		{"amended", Legislation{Amendments: []Amendment{original}}, Legislation{Amendments: []Amendment{original, amended}}, []AmendmentChange{
+			{Date: d2, Version: "A", PrintNo: "S1234A", FromPrintNo: "S1234", Diff: "- b\n+ B\n"},
+		}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := CalculateAmendmentChanges(tc.a, tc.b)
+			if len(got) != len(tc.expected) {
+				t.Fatalf("got %d changes %#v expected %d", len(got), got, len(tc.expected))
+			}
+			for i := range got {
+				if got[i] != tc.expected[i] {
+					t.Errorf("[%d] got %#v expected %#v", i, got[i], tc.expected[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package legislature
+
+import (
+	"sync"
+	"time"
+)
+
+// Term is a Session with exact dates. A regular term runs from when it convenes
+// (Start) until the next term convenes (End).
+type Term struct {
+	Session
+	Name    string    `json:",omitempty"`
+	Start   time.Time // inclusive
+	End     time.Time // exclusive
+	Special bool      // a special (or extraordinary) session held during Session
+}
+
+// Contains returns if t is between Start and End
+func (t Term) Contains(now time.Time) bool {
+	return !now.Before(t.Start) && now.Before(t.End)
+}
+
+// Calendar is the schedule of sessions for a body. Regular sessions are
+// generated from FirstYear so future sessions don't need to be listed.
+type Calendar struct {
+	// FirstYear is the StartYear of the first generated session; Lengths are the
+	// session lengths (in years) repeated from FirstYear. i.e. [2] for a two year session.
+	// When Lengths is empty only Terms are used
+	FirstYear int
+	Lengths   []int
+
+	// regular sessions convene on this date (default January 1st)
+	ConveneMonth time.Month
+	ConveneDay   int
+
+	// LameDuck is how long after a term ends that legislation is still active (i.e. while bills are signed)
+	LameDuck time.Duration
+
+	// Terms are explicit sessions. Regular terms are used for sessions before FirstYear
+	// or to set the dates of a generated session. Special terms are in addition to the regular session.
+	Terms []Term
+}
+
+func (c Calendar) convene(year int) time.Time {
+	m, d := c.ConveneMonth, c.ConveneDay
+	if m == 0 {
+		m = time.January
+	}
+	if d == 0 {
+		d = 1
+	}
+	return time.Date(year, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// explicit returns the listed regular term that includes year
+func (c Calendar) explicit(year int) (Term, bool) {
+	for _, t := range c.Terms {
+		if !t.Special && year >= t.StartYear && year <= t.EndYear {
+			return t, true
+		}
+	}
+	return Term{}, false
+}
+
+// dated fills in missing Start and End dates
+func (c Calendar) dated(t Term) Term {
+	if t.Start.IsZero() {
+		t.Start = c.convene(t.StartYear)
+	}
+	if t.End.IsZero() {
+		t.End = c.convene(t.EndYear + 1)
+		if next, ok := c.explicit(t.EndYear + 1); ok && !next.Start.IsZero() {
+			t.End = next.Start
+		}
+	}
+	return t
+}
+
+// regular returns the regular term that includes year
+func (c Calendar) regular(year int) (Term, bool) {
+	if t, ok := c.explicit(year); ok {
+		return c.dated(t), true
+	}
+	if len(c.Lengths) == 0 || year < c.FirstYear {
+		return Term{}, false
+	}
+	start := c.FirstYear
+	for i := 0; ; i++ {
+		l := c.Lengths[i%len(c.Lengths)]
+		if l < 1 {
+			return Term{}, false
+		}
+		if year < start+l {
+			return c.dated(Term{Session: Session{StartYear: start, EndYear: start + l - 1}}), true
+		}
+		start += l
+	}
+}
+
+// Find returns the regular session that includes year
+func (c Calendar) Find(year int) Session {
+	t, _ := c.regular(year)
+	return t.Session
+}
+
+// Term returns the dates of a regular session
+func (c Calendar) Term(s Session) (Term, bool) {
+	t, ok := c.regular(s.StartYear)
+	if !ok || t.Session != s {
+		return Term{}, false
+	}
+	return t, true
+}
+
+// At returns the regular term in session at a point in time
+func (c Calendar) At(now time.Time) (Term, bool) {
+	now = now.UTC()
+	for _, year := range []int{now.Year(), now.Year() - 1} {
+		if t, ok := c.regular(year); ok && t.Contains(now) {
+			return t, true
+		}
+	}
+	return Term{}, false
+}
+
+// Current returns the regular session in progress
+func (c Calendar) Current() Session {
+	t, _ := c.At(time.Now())
+	return t.Session
+}
+
+// Sessions returns the regular sessions that include from - through (newest first)
+func (c Calendar) Sessions(from, through int) Sessions {
+	var out Sessions
+	for year := through; year >= from; year-- {
+		t, ok := c.regular(year)
+		if !ok {
+			continue
+		}
+		if len(out) == 0 || out[len(out)-1] != t.Session {
+			out = append(out, t.Session)
+		}
+	}
+	return out
+}
+
+// Active returns if legislation in session s is still active at now. This includes
+// the LameDuck period after a session and any special session.
+func (c Calendar) Active(s Session, now time.Time) bool {
+	if t, ok := c.Term(s); ok {
+		if !now.Before(t.Start) && now.Before(t.End.Add(c.LameDuck)) {
+			return true
+		}
+	} else if !c.known(s) {
+		return s.activeAt(now)
+	}
+	for _, t := range c.Terms {
+		if t.Special && t.Session == s && t.Contains(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// known returns if s is a regular or special session on the calendar
+func (c Calendar) known(s Session) bool {
+	if _, ok := c.Term(s); ok {
+		return true
+	}
+	for _, t := range c.Terms {
+		if t.Session == s {
+			return true
+		}
+	}
+	return false
+}
+
+var calendars = struct {
+	sync.RWMutex
+	m map[BodyID]*Calendar
+}{m: make(map[BodyID]*Calendar)}
+
+// RegisterCalendar sets the session calendar used for legislation in a body; see Legislation.Active
+func RegisterCalendar(body BodyID, c *Calendar) {
+	calendars.Lock()
+	defer calendars.Unlock()
+	if c == nil {
+		delete(calendars.m, body)
+		return
+	}
+	calendars.m[body] = c
+}
+
+// CalendarFor returns the registered calendar for a body
+func CalendarFor(body BodyID) (*Calendar, bool) {
+	calendars.RLock()
+	defer calendars.RUnlock()
+	c, ok := calendars.m[body]
+	return c, ok
+}
+
+// Active returns if the legislation is in an active session according to the body's
+// calendar. Bodies without a calendar are active for the years of the session.
+func (l Legislation) Active() bool { return l.ActiveAt(time.Now()) }
+
+func (l Legislation) ActiveAt(now time.Time) bool {
+	if c, ok := CalendarFor(l.Body); ok {
+		return c.Active(l.Session, now)
+	}
+	return l.Session.activeAt(now)
+}
@@ -0,0 +1,110 @@
+package legislature
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalendarFind(t *testing.T) {
+	c := Calendar{
+		FirstYear: 2002,
+		Lengths:   []int{2, 2, 4, 4, 4, 4},
+		Terms:     []Term{{Session: Session{StartYear: 1998, EndYear: 2001}}},
+	}
+	tests := []struct {
+		year int
+		want Session
+	}{
+		{1997, Session{}},
+		{1999, Session{1998, 2001}},
+		{2003, Session{2002, 2003}},
+		{2007, Session{2006, 2009}},
+		{2023, Session{2022, 2023}},
+		{2025, Session{2024, 2025}},
+		{2026, Session{2026, 2029}},
+		{2043, Session{2042, 2043}},
+		{2046, Session{2046, 2049}},
+	}
+	for _, tc := range tests {
+		if got := c.Find(tc.year); got != tc.want {
+			t.Errorf("Find(%d) got %v expected %v", tc.year, got, tc.want)
+		}
+	}
+
+	want := Sessions{{2026, 2029}, {2024, 2025}, {2022, 2023}}
+	if got := c.Sessions(2022, 2027); !reflect.DeepEqual(got, want) {
+		t.Errorf("Sessions got %v expected %v", got, want)
+	}
+}
+
+func TestCalendarAt(t *testing.T) {
+	congress := Calendar{FirstYear: 1935, Lengths: []int{2}, ConveneMonth: time.January, ConveneDay: 3}
+	tests := []struct {
+		now  time.Time
+		want Session
+	}{
+		{date(2024, time.December, 20), Session{2023, 2024}},
+		{date(2025, time.January, 2), Session{2023, 2024}},
+		{date(2025, time.January, 3), Session{2025, 2026}},
+		{date(2026, time.June, 1), Session{2025, 2026}},
+	}
+	for _, tc := range tests {
+		got, ok := congress.At(tc.now)
+		if !ok || got.Session != tc.want {
+			t.Errorf("At(%s) got %v expected %v", tc.now.Format(time.DateOnly), got.Session, tc.want)
+		}
+	}
+}
+
+func TestCalendarActive(t *testing.T) {
+	c := Calendar{
+		FirstYear: 2022,
+		Lengths:   []int{2},
+		LameDuck:  30 * 24 * time.Hour,
+		Terms: []Term{
+			{Session: Session{2024, 2025}, End: date(2026, time.January, 7)},
+			{Session: Session{2020, 2020}, Name: "Special", Special: true, Start: date(2020, time.June, 1), End: date(2020, time.June, 10)},
+		},
+	}
+	tests := []struct {
+		name    string
+		session Session
+		now     time.Time
+		want    bool
+	}{
+		{"in session", Session{2024, 2025}, date(2025, time.June, 1), true},
+		{"january", Session{2024, 2025}, date(2026, time.January, 2), true},
+		{"lame duck", Session{2024, 2025}, date(2026, time.February, 5), true},
+		{"after lame duck", Session{2024, 2025}, date(2026, time.February, 7), false},
+		{"next session", Session{2026, 2027}, date(2026, time.January, 8), true},
+		{"before session", Session{2026, 2027}, date(2025, time.December, 1), false},
+		{"special", Session{2020, 2020}, date(2020, time.June, 5), true},
+		{"after special", Session{2020, 2020}, date(2020, time.July, 1), false},
+		{"unknown session", Session{2010, 2011}, date(2011, time.March, 1), true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := c.Active(tc.session, tc.now); got != tc.want {
+				t.Errorf("got %v expected %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLegislationActive(t *testing.T) {
+	l := Legislation{Body: "test-calendar", Session: Session{2024, 2025}}
+	now := date(2026, time.January, 10)
+	if l.ActiveAt(now) {
+		t.Errorf("expected inactive without a calendar")
+	}
+	RegisterCalendar(l.Body, &Calendar{FirstYear: 2024, Lengths: []int{2}, LameDuck: 30 * 24 * time.Hour})
+	defer RegisterCalendar(l.Body, nil)
+	if !l.ActiveAt(now) {
+		t.Errorf("expected active during lame duck")
+	}
+}
@@ -27,10 +27,12 @@ type Legislation struct {
 	URL         string
 	Session     Session
 	Status      string
+	Actions     []Action        // history of the bill in chronological order
+	Amendments  []Amendment     `firestore:",omitempty" json:",omitempty"` // versions of the bill in order
 	Type        LegislationType // i.e. Resolution, Bill, etc
 	// Committee ?
-	// Prime Sponsor
-	Sponsors []Member
+	Sponsors          []Member
+	WithdrawnSponsors []Member `firestore:",omitempty" json:",omitempty"` // former sponsors (when provided upstream)
 
 	// for Bicameral legislatures
 	SameAs        LegislationID // the bill in the other house (if exists)
@@ -87,15 +89,17 @@ func (g GlobalID) String() string {
 
 // Body represents a specific legislature
 type Body struct {
-	ID         BodyID
-	DisplayID  string
-	Name       string
-	Location   string // ex: New York
-	URL        string
-	MemberName string
-	Bicameral  BodyID                       // In a bicameral legislature, the other half
-	UpperHouse bool                         // In a bicameral legislature, the upper house
-	Sort       func(a, b *Legislation) bool `json:"-"`
+	ID          BodyID
+	DisplayID   string
+	Name        string
+	Location    string // ex: New York
+	URL         string
+	MemberName  string
+	Bicameral   BodyID                       // In a bicameral legislature, the other half
+	UpperHouse  bool                         // In a bicameral legislature, the upper house
+	Unavailable bool                         `json:",omitempty"` // the resolver is disabled (i.e. missing API credentials)
+	Sort        func(a, b *Legislation) bool `json:"-"`
+	Calendar    *Calendar                    `json:"-"` // optional; see RegisterCalendar
 }
 
 type Resolver interface {
@@ -104,7 +108,7 @@ type Resolver interface {
 	Body() Body
 	Scorecard(context.Context, []Scorable) (*Scorecard, error)
 	Members(context.Context, Session) ([]Member, error)
-	// Votes
+	Votes(context.Context, LegislationID) ([]RollCall, error)
 
 	Link(l LegislationID) *url.URL
 	DisplayID(l LegislationID) string
@@ -112,6 +116,19 @@ type Resolver interface {
 }
 type Resolvers []Resolver
 
+// Searcher is implemented by resolvers that can find legislation by keyword (or bill number)
+//
+// A zero Session searches the current session
+type Searcher interface {
+	Search(ctx context.Context, query string, session Session) ([]Legislation, error)
+}
+
+// CitationParser is implemented by resolvers that can parse a bill citation (i.e. "S1234" or "H.R. 5")
+// into a LegislationID. A zero Session is the current session
+type CitationParser interface {
+	ParseCitation(citation string, session Session) (LegislationID, bool)
+}
+
 func (r Resolvers) Find(ID BodyID) Resolver {
 	for _, rr := range r {
 		if rr.Body().ID == ID {
@@ -191,14 +208,18 @@ func GenericLegislationSort(a, b *Legislation) bool {
 }
 
 var ErrNotFound = errors.New("Not Found")
+var ErrUnavailable = errors.New("legislature unavailable")
 
 type Session struct {
 	StartYear, EndYear int // inclusive
 }
 
-func (s Session) Active() bool {
-	now := time.Now().UTC().Year()
-	return s.EndYear >= now && s.StartYear <= now
+// Active returns if the current year is within the session. Use Legislation.Active
+// (or Calendar.Active) for the exact session dates of a body.
+func (s Session) Active() bool { return s.activeAt(time.Now()) }
+func (s Session) activeAt(now time.Time) bool {
+	year := now.UTC().Year()
+	return s.EndYear >= year && s.StartYear <= year
 }
 func (s Session) String() string { return fmt.Sprintf("%d-%d", s.StartYear, s.EndYear) }
 
@@ -235,6 +256,33 @@ type Member struct {
 	District  string `firestore:",omitempty"`
 	Party     string `firestore:",omitempty"`
 	// Todo body?
+
+	// only set for Legislation.Sponsors and Legislation.WithdrawnSponsors
+	Role          SponsorRole `firestore:",omitempty" json:",omitempty"`
+	SponsorDate   time.Time   `firestore:",omitempty" json:",omitempty"` // when provided upstream
+	WithdrawnDate time.Time   `firestore:",omitempty" json:",omitempty"`
+}
+
+// SponsorRole distinguishes the prime sponsor of legislation from cosponsors
+type SponsorRole string
+
+const (
+	PrimeSponsor SponsorRole = "Prime Sponsor"
+	Cosponsor    SponsorRole = "Cosponsor"
+	MultiSponsor SponsorRole = "Multi-Sponsor" // NY Assembly bills with a multi-sponsor memo
+)
+
+// IsPrime is true for the prime sponsor of legislation
+func (m Member) IsPrime() bool { return m.Role == PrimeSponsor }
+
+// PrimeSponsor returns the prime sponsor (if known)
+func (l Legislation) PrimeSponsor() *Member {
+	for i, m := range l.Sponsors {
+		if m.IsPrime() {
+			return &l.Sponsors[i]
+		}
+	}
+	return nil
 }
 
 func (m Member) ID() string {
@@ -250,7 +298,21 @@ type SponsorChange struct {
 	Member   Member
 }
 
+// Action describes the change. i.e. "Sponsored", "Prime Sponsored" or "Sponsor Withdrawn"
+func (s SponsorChange) Action() string {
+	switch {
+	case s.Withdraw:
+		return "Sponsor Withdrawn"
+	case s.Member.IsPrime():
+		return "Prime Sponsored"
+	}
+	return "Sponsored"
+}
+
 // CalculateSponsorChanges returns a list of changes in .Sponsors from a to b
+//
+// The upstream sponsor (or withdrawal) date is used when known, otherwise
+// b.LastModified or the current time
 func CalculateSponsorChanges(a, b Legislation) []SponsorChange {
 	have := make(map[string]bool, len(a.Sponsors))
 	members := make(map[string]Member, len(a.Sponsors))
@@ -259,26 +321,139 @@ func CalculateSponsorChanges(a, b Legislation) []SponsorChange {
 		have[m.ID()] = true
 		members[m.ID()] = m
 	}
+	withdrawn := make(map[string]Member, len(b.WithdrawnSponsors))
+	for _, m := range b.WithdrawnSponsors {
+		withdrawn[m.ID()] = m
+	}
 	date := b.LastModified
 	if date.IsZero() {
 		date = time.Now().UTC()
 	}
 	for _, m := range b.Sponsors {
 		if !have[m.ID()] {
-			changes = append(changes, SponsorChange{Date: date, Member: m})
+			changes = append(changes, SponsorChange{Date: firstDate(m.SponsorDate, date), Member: m})
 		}
 		have[m.ID()] = false
 	}
 	for memberID, v := range have {
 		if v {
-			changes = append(changes, SponsorChange{Date: date, Member: members[memberID], Withdraw: true})
+			m := members[memberID]
+			if w, ok := withdrawn[memberID]; ok {
+				m.WithdrawnDate = w.WithdrawnDate
+			}
+			changes = append(changes, SponsorChange{Date: firstDate(m.WithdrawnDate, date), Member: m, Withdraw: true})
 		}
 	}
 	return changes
 }
 
+// SponsorHistory returns the sponsor changes known from upstream dates for a
+// bill that has not been seen before: cosponsors added after the bill was
+// introduced and withdrawn sponsors
+func SponsorHistory(l Legislation) []SponsorChange {
+	var changes []SponsorChange
+	for _, members := range [][]Member{l.Sponsors, l.WithdrawnSponsors} {
+		for _, m := range members {
+			if m.IsPrime() || m.SponsorDate.IsZero() || l.IntroducedDate.IsZero() {
+				continue
+			}
+			if m.SponsorDate.After(l.IntroducedDate.Add(24 * time.Hour)) {
+				changes = append(changes, SponsorChange{Date: m.SponsorDate, Member: m})
+			}
+		}
+	}
+	for _, m := range l.WithdrawnSponsors {
+		if !m.WithdrawnDate.IsZero() {
+			changes = append(changes, SponsorChange{Date: m.WithdrawnDate, Member: m, Withdraw: true})
+		}
+	}
+	return changes
+}
+
+func firstDate(d ...time.Time) time.Time {
+	for _, dd := range d {
+		if !dd.IsZero() {
+			return dd
+		}
+	}
+	return time.Time{}
+}
+
+// StatusChange is a significant step in the progress of a bill such as
+// being reported out of committee, passing a chamber or being signed
+type StatusChange struct {
+	Date    time.Time
+	From    string `firestore:",omitempty" json:",omitempty"` // the previous Status
+	Status  string // the new Status or the text of the action
+	Kind    ActionKind
+	Chamber string `firestore:",omitempty" json:",omitempty"`
+}
+
+// CalculateStatusChanges returns significant status transitions from a to b
+//
+// When both versions have an action history each new significant action is a change,
+// otherwise a change in the Status text is used.
+func CalculateStatusChanges(a, b Legislation) []StatusChange {
+	if len(a.Actions) == 0 || len(b.Actions) == 0 {
+		if a.Status == b.Status || b.Status == "" {
+			return nil
+		}
+		c := StatusChange{
+			Date:   b.LastModified,
+			From:   a.Status,
+			Status: b.Status,
+			Kind:   ActionKindFromText(b.Status),
+		}
+		if latest := b.LatestAction(); latest != nil {
+			c.Date, c.Kind, c.Chamber = latest.Date, latest.Kind, latest.Chamber
+		}
+		if c.Date.IsZero() {
+			c.Date = time.Now().UTC()
+		}
+		return []StatusChange{c}
+	}
+
+	type key struct {
+		Date time.Time
+		Text string
+	}
+	have := make(map[key]bool, len(a.Actions))
+	for _, action := range a.Actions {
+		have[key{action.Date, action.Text}] = true
+	}
+	var changes []StatusChange
+	for _, action := range b.Actions {
+		if have[key{action.Date, action.Text}] || !action.Kind.IsStatusChange() {
+			continue
+		}
+		changes = append(changes, StatusChange{
+			Date:    action.Date,
+			From:    a.Status,
+			Status:  action.Text,
+			Kind:    action.Kind,
+			Chamber: action.Chamber,
+		})
+	}
+	return changes
+}
+
 type Changes struct {
-	Sponsors []SponsorChange
+	Sponsors   []SponsorChange
+	Status     []StatusChange    `firestore:",omitempty" json:",omitempty"`
+	Amendments []AmendmentChange `firestore:",omitempty" json:",omitempty"`
+}
+
+// CalculateChanges returns all the changes from a to b
+func CalculateChanges(a, b Legislation) Changes {
+	return Changes{
+		Sponsors:   CalculateSponsorChanges(a, b),
+		Status:     CalculateStatusChanges(a, b),
+		Amendments: CalculateAmendmentChanges(a, b),
+	}
+}
+
+func (c Changes) Empty() bool {
+	return len(c.Sponsors) == 0 && len(c.Status) == 0 && len(c.Amendments) == 0
 }
 
 type ResubmitMapping map[GlobalID]GlobalID
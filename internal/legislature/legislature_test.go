@@ -55,3 +55,96 @@ func TestCalculateSponsorChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestSponsorChangeDates(t *testing.T) {
+	modified := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	sponsored := time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC)
+	withdrawn := time.Date(2025, 4, 5, 0, 0, 0, 0, time.UTC)
+	a := Legislation{Sponsors: []Member{{NumericID: 1}, {NumericID: 2}}}
+	b := Legislation{
+		LastModified:      modified,
+		Sponsors:          []Member{{NumericID: 1}, {NumericID: 3, SponsorDate: sponsored}, {NumericID: 4}},
+		WithdrawnSponsors: []Member{{NumericID: 2, WithdrawnDate: withdrawn}},
+	}
+	want := map[int]time.Time{3: sponsored, 4: modified, 2: withdrawn}
+	got := CalculateSponsorChanges(a, b)
+	if len(got) != len(want) {
+		t.Fatalf("got %d changes %#v", len(got), got)
+	}
+	for _, c := range got {
+		if !c.Date.Equal(want[c.Member.NumericID]) {
+			t.Errorf("member %d got %s want %s", c.Member.NumericID, c.Date, want[c.Member.NumericID])
+		}
+	}
+}
+
+func TestSponsorHistory(t *testing.T) {
+	introduced := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)
+	later := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	withdrawn := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	l := Legislation{
+		IntroducedDate: introduced,
+		Sponsors: []Member{
+			{NumericID: 1, Role: PrimeSponsor, SponsorDate: introduced},
+			{NumericID: 2, Role: Cosponsor, SponsorDate: introduced}, // original cosponsor
+			{NumericID: 3, Role: Cosponsor, SponsorDate: later},
+			{NumericID: 4, Role: Cosponsor}, // unknown date
+		},
+		WithdrawnSponsors: []Member{
+			{NumericID: 5, Role: Cosponsor, SponsorDate: later, WithdrawnDate: withdrawn},
+		},
+	}
+	got := SponsorHistory(l)
+	want := []SponsorChange{
+		{Date: later, Member: l.Sponsors[2]},
+		{Date: later, Member: l.WithdrawnSponsors[0]},
+		{Date: withdrawn, Member: l.WithdrawnSponsors[0], Withdraw: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SponsorHistory() = %#v, want %#v", got, want)
+	}
+}
+
+func TestCalculateStatusChanges(t *testing.T) {
+	d1 := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)
+	d2 := time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)
+	referred := Action{Date: d1, Chamber: "Senate", Text: "REFERRED TO HEALTH", Kind: ReferredAction}
+	passed := Action{Date: d2, Chamber: "Senate", Text: "PASSED SENATE", Kind: PassedAction}
+	tests := []struct {
+		name string
+		a, b Legislation
+		want []StatusChange
+	}{
+		{
+			name: "no changes",
+			a:    Legislation{Status: "In Committee"},
+			b:    Legislation{Status: "In Committee"},
+		},
+		{
+			name: "status text changed without actions",
+			a:    Legislation{Status: "In Committee"},
+			b:    Legislation{Status: "Passed Senate", LastModified: d2},
+			want: []StatusChange{{Date: d2, From: "In Committee", Status: "Passed Senate", Kind: PassedAction}},
+		},
+		{
+			name: "new significant action",
+			a:    Legislation{Status: "In Committee", Actions: []Action{referred}},
+			b:    Legislation{Status: "Passed Senate", Actions: []Action{referred, passed}},
+			want: []StatusChange{{Date: d2, From: "In Committee", Status: "PASSED SENATE", Kind: PassedAction, Chamber: "Senate"}},
+		},
+		{
+			name: "new insignificant action",
+			a:    Legislation{Status: "Introduced", Actions: []Action{{Date: d1, Text: "INTRODUCED", Kind: IntroducedAction}}},
+			b:    Legislation{Status: "In Committee", Actions: []Action{{Date: d1, Text: "INTRODUCED", Kind: IntroducedAction}, referred}},
+		},
+	}
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			t.Log(tc.name)
+			got := CalculateStatusChanges(tc.a, tc.b)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("CalculateStatusChanges() = %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
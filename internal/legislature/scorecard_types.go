@@ -26,6 +26,7 @@ type ScoredBookmark struct {
 	Committee string
 
 	Oppose bool
+	Weight int // the scoring weight; 0 is 1
 	// Tags   []string
 
 	Scores []Score
@@ -36,6 +37,7 @@ type Scorecard struct {
 	Metadata ScorecardMetadata
 	People   []ScorecardPerson
 	Data     []ScoredBookmark
+	Policy   *ScoringPolicy `json:",omitempty"` // nil is DefaultScoringPolicy
 }
 
 type Score struct {
@@ -46,12 +48,13 @@ type Score struct {
 type PersonWhipCount struct {
 	ScorecardPerson
 	WhipCount
+	Weighted WeightedScore
 }
 
 func (s Score) Score() int {
 	if s.Desired {
 		switch strings.ToLower(s.Status) {
-		case "affirmative", "aye", "sponsor":
+		case "affirmative", "aye", "sponsor", "prime sponsor", "cosponsor", "multi-sponsor":
 			return 1
 		case "negative", "nay":
 			return -1
@@ -59,7 +62,7 @@ func (s Score) Score() int {
 		return 0
 	}
 	switch strings.ToLower(s.Status) {
-	case "affirmative", "aye", "sponsor":
+	case "affirmative", "aye", "sponsor", "prime sponsor", "cosponsor", "multi-sponsor":
 		return -1
 	case "negative", "nay":
 		return 1
@@ -68,10 +71,13 @@ func (s Score) Score() int {
 	}
 }
 
+// IsPrimeSponsor is true when the person is the prime sponsor
+func (s Score) IsPrimeSponsor() bool { return s.Status == string(PrimeSponsor) }
+
 func (s Score) CSS() string {
 	if s.Desired {
 		switch strings.ToLower(s.Status) {
-		case "affirmative", "aye", "sponsor":
+		case "affirmative", "aye", "sponsor", "prime sponsor", "cosponsor", "multi-sponsor":
 			return "affirmative"
 		case "negative", "nay":
 			return "negative"
@@ -82,7 +88,7 @@ func (s Score) CSS() string {
 		}
 	}
 	switch strings.ToLower(s.Status) {
-	case "affirmative", "aye", "sponsor":
+	case "affirmative", "aye", "sponsor", "prime sponsor", "cosponsor", "multi-sponsor":
 		return "negative"
 	case "negative", "nay":
 		return "affirmative"
@@ -0,0 +1,183 @@
+package legislature
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strings"
+)
+
+// ScoringPolicy sets the points a Scorecard awards for each Score.Status. Points are
+// multiplied by the bookmark weight (see ScoredBookmark.Weight)
+type ScoringPolicy struct {
+	SponsorPoints     float64 // sponsor, cosponsor, multi-sponsor
+	PrimeSponsorBonus float64 // extra points for the prime sponsor
+	VotePoints        float64 // aye, nay
+
+	// AbsentCountsAgainst scores excused, absent, present, not voting and recused as
+	// an incorrect vote instead of no score
+	AbsentCountsAgainst bool
+}
+
+// DefaultScoringPolicy scores a sponsor and a vote equally and skips absences
+var DefaultScoringPolicy = ScoringPolicy{
+	SponsorPoints: 1,
+	VotePoints:    1,
+}
+
+// MaxPoints is the most points a ScoringPolicy can award for a sponsor or vote
+const MaxPoints = 100
+
+// ValidPoints is true for a finite number of points from 0 to MaxPoints
+func ValidPoints(f float64) bool {
+	return !math.IsNaN(f) && !math.IsInf(f, 0) && f >= 0 && f <= MaxPoints
+}
+
+// IsValid is true when each of the points is ValidPoints
+func (p ScoringPolicy) IsValid() bool {
+	return ValidPoints(p.SponsorPoints) && ValidPoints(p.PrimeSponsorBonus) && ValidPoints(p.VotePoints)
+}
+
+func isSponsorStatus(status string) bool {
+	switch strings.ToLower(status) {
+	case "sponsor", "prime sponsor", "cosponsor", "multi-sponsor":
+		return true
+	}
+	return false
+}
+
+// Points returns the signed points for s; positive when s is in the desired direction
+func (p ScoringPolicy) Points(s Score) float64 {
+	var points float64
+	switch {
+	case isSponsorStatus(s.Status):
+		points = p.SponsorPoints
+		if s.IsPrimeSponsor() {
+			points += p.PrimeSponsorBonus
+		}
+	default:
+		points = p.VotePoints
+	}
+	switch s.Score() {
+	case 1:
+		return points
+	case -1:
+		return -points
+	}
+	if s.Status != "" && p.AbsentCountsAgainst {
+		return -p.VotePoints
+	}
+	return 0
+}
+
+// Possible is the most points a bill can award (excluding PrimeSponsorBonus); VotePoints
+// when the bill has a recorded vote, otherwise SponsorPoints
+func (p ScoringPolicy) Possible(c ScoredBookmark) float64 {
+	if c.HasVote() {
+		return p.VotePoints
+	}
+	return p.SponsorPoints
+}
+
+// HasVote is true when any score is a vote (or an absence from a vote) instead of a sponsor
+func (c ScoredBookmark) HasVote() bool {
+	for _, s := range c.Scores {
+		if s.Status != "" && !isSponsorStatus(s.Status) {
+			return true
+		}
+	}
+	return false
+}
+
+// WeightedScore is a person's points on a scorecard out of the points possible
+type WeightedScore struct {
+	Points   float64
+	Possible float64
+}
+
+// Percent returns in the range [-100, 100]. A prime sponsor bonus can't raise it above 100
+func (w WeightedScore) Percent() float64 {
+	if w.Possible == 0 {
+		return 0
+	}
+	return min(max(w.Points/w.Possible*100, -100), 100)
+}
+
+// Grade is a letter grade (A, B, C, D or F) for Percent
+func (w WeightedScore) Grade() string {
+	p := w.Percent()
+	switch {
+	case p >= 90:
+		return "A"
+	case p >= 80:
+		return "B"
+	case p >= 70:
+		return "C"
+	case p >= 60:
+		return "D"
+	}
+	return "F"
+}
+
+// MarshalJSON includes the Percent and Grade
+func (w WeightedScore) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Points   float64
+		Possible float64
+		Percent  float64
+		Grade    string
+	}{w.Points, w.Possible, w.Percent(), w.Grade()})
+}
+
+// ScoringPolicy returns the Policy or DefaultScoringPolicy
+func (c Scorecard) ScoringPolicy() ScoringPolicy {
+	if c.Policy != nil {
+		return *c.Policy
+	}
+	return DefaultScoringPolicy
+}
+
+// WeightedScore totals the weighted points for People[idx] using the ScoringPolicy
+func (c Scorecard) WeightedScore(idx int) (w WeightedScore) {
+	policy := c.ScoringPolicy()
+	for _, cc := range c.Data {
+		weight := float64(cc.weight())
+		w.Possible += weight * policy.Possible(cc)
+		w.Points += weight * policy.Points(cc.Scores[idx])
+	}
+	return
+}
+
+// Weighted is true when any bookmark has a weight other than 1
+func (c Scorecard) Weighted() bool {
+	for _, cc := range c.Data {
+		if cc.weight() != 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// weight defaults to 1
+func (c ScoredBookmark) weight() int {
+	if c.Weight <= 0 {
+		return 1
+	}
+	return c.Weight
+}
+
+// PersonWhipCounts returns the whip count and weighted score for each person, highest weighted score first
+func (c Scorecard) PersonWhipCounts() []PersonWhipCount {
+	out := make([]PersonWhipCount, 0, len(c.People))
+	for i, p := range c.People {
+		out = append(out, PersonWhipCount{
+			ScorecardPerson: p,
+			WhipCount:       c.WhipCount(i),
+			Weighted:        c.WeightedScore(i),
+		})
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Weighted.Percent() > out[j].Weighted.Percent()
+	})
+	return out
+}
@@ -0,0 +1,131 @@
+package legislature
+
+import (
+	"math"
+	"testing"
+)
+
+func TestScoringPolicyPoints(t *testing.T) {
+	policy := ScoringPolicy{SponsorPoints: 2, PrimeSponsorBonus: 1, VotePoints: 1}
+	strict := policy
+	strict.AbsentCountsAgainst = true
+	type testCase struct {
+		policy   ScoringPolicy
+		score    Score
+		expected float64
+	}
+	tests := []testCase{
+		{DefaultScoringPolicy, Score{Status: "Sponsor", Desired: true}, 1},
+		{DefaultScoringPolicy, Score{Status: "Aye", Desired: false}, -1},
+		{DefaultScoringPolicy, Score{Status: "Excused", Desired: true}, 0},
+		{policy, Score{Status: "Sponsor", Desired: true}, 2},
+		{policy, Score{Status: "Prime Sponsor", Desired: true}, 3},
+		{policy, Score{Status: "Prime Sponsor", Desired: false}, -3},
+		{policy, Score{Status: "Aye", Desired: true}, 1},
+		{policy, Score{Status: "Nay", Desired: true}, -1},
+		{policy, Score{Status: "Absent", Desired: true}, 0},
+		{strict, Score{Status: "Absent", Desired: true}, -1},
+		{strict, Score{Status: "Excused", Desired: false}, -1},
+		{strict, Score{Status: "", Desired: true}, 0},
+	}
+	for _, tc := range tests {
+		if got := tc.policy.Points(tc.score); got != tc.expected {
+			t.Errorf("%#v Points(%#v) got %v expected %v", tc.policy, tc.score, got, tc.expected)
+		}
+	}
+}
+
+func TestScorecardWeightedScore(t *testing.T) {
+	s := Scorecard{
+		People: []ScorecardPerson{{FullName: "a"}, {FullName: "b"}, {FullName: "c"}},
+		Data: []ScoredBookmark{
+			{Weight: 3, Scores: []Score{{"Aye", true}, {"Nay", true}, {"Prime Sponsor", true}}},
+			{Scores: []Score{{"Nay", true}, {"Aye", true}, {"Excused", true}}},
+		},
+	}
+	type testCase struct {
+		policy  *ScoringPolicy
+		idx     int
+		percent float64
+		grade   string
+	}
+	tests := []testCase{
+		// (3 - 1) / 4
+		{nil, 0, 50, "F"},
+		{nil, 1, -50, "F"},
+		{nil, 2, 75, "C"},
+		// both bills have a vote so (6 + 0) / 4 capped at 100
+		{&ScoringPolicy{SponsorPoints: 2, VotePoints: 1}, 2, 100, "A"},
+		// (3 - 1) / 4
+		{&ScoringPolicy{SponsorPoints: 3, VotePoints: 1}, 0, 50, "F"},
+		// (6 + 3 - 1) / 4 capped at 100
+		{&ScoringPolicy{SponsorPoints: 2, PrimeSponsorBonus: 1, VotePoints: 1, AbsentCountsAgainst: true}, 2, 100, "A"},
+	}
+	for _, tc := range tests {
+		s.Policy = tc.policy
+		got := s.WeightedScore(tc.idx)
+		if got.Percent() != tc.percent || got.Grade() != tc.grade {
+			t.Errorf("%#v WeightedScore(%d) got %v%% %s expected %v%% %s", tc.policy, tc.idx, got.Percent(), got.Grade(), tc.percent, tc.grade)
+		}
+	}
+
+	s.Policy = nil
+	people := s.PersonWhipCounts()
+	if people[0].FullName != "c" || people[2].FullName != "b" {
+		t.Errorf("unexpected order %#v", people)
+	}
+}
+
+func TestScorecardWeightedScoreUnequalPoints(t *testing.T) {
+	policy := &ScoringPolicy{SponsorPoints: 3, VotePoints: 1}
+	people := []ScorecardPerson{{FullName: "a"}, {FullName: "b"}}
+	voted := []ScoredBookmark{
+		{Scores: []Score{{"Aye", true}, {"Nay", true}}},
+		{Scores: []Score{{"Nay", false}, {"Sponsor", true}}},
+	}
+	sponsored := ScoredBookmark{Scores: []Score{{"", true}, {"Sponsor", true}}}
+	type testCase struct {
+		data    []ScoredBookmark
+		idx     int
+		percent float64
+		grade   string
+	}
+	tests := []testCase{
+		// every recorded vote was correct: (1 + 1) / 2
+		{voted, 0, 100, "A"},
+		// (-1 + 3) / 2
+		{voted, 1, 100, "A"},
+		// no vote on the last bill so it's out of the sponsor points: (1 + 1 + 0) / 5
+		{append(voted, sponsored), 0, 40, "F"},
+		// (-1 + 3 + 3) / 5
+		{append(voted, sponsored), 1, 100, "A"},
+	}
+	for i, tc := range tests {
+		s := Scorecard{People: people, Data: tc.data, Policy: policy}
+		got := s.WeightedScore(tc.idx)
+		if got.Percent() != tc.percent || got.Grade() != tc.grade {
+			t.Errorf("[%d] WeightedScore(%d) got %v%% %s expected %v%% %s", i, tc.idx, got.Percent(), got.Grade(), tc.percent, tc.grade)
+		}
+	}
+}
+
+func TestScoringPolicyIsValid(t *testing.T) {
+	type testCase struct {
+		p        ScoringPolicy
+		expected bool
+	}
+	tests := []testCase{
+		{DefaultScoringPolicy, true},
+		{ScoringPolicy{}, true},
+		{ScoringPolicy{SponsorPoints: MaxPoints, VotePoints: 0.5}, true},
+		{ScoringPolicy{SponsorPoints: -1}, false},
+		{ScoringPolicy{VotePoints: MaxPoints + 1}, false},
+		{ScoringPolicy{VotePoints: math.Inf(1)}, false},
+		{ScoringPolicy{PrimeSponsorBonus: math.NaN()}, false},
+	}
+	for i, tc := range tests {
+		if got := tc.p.IsValid(); got != tc.expected {
+			t.Errorf("[%d] %#v got %v expected %v", i, tc.p, got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package legislature
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// VotePosition is how a member voted. The values are also used as Score.Status
+type VotePosition string
+
+const (
+	Aye       VotePosition = "Aye"
+	Nay       VotePosition = "Nay"
+	Excused   VotePosition = "Excused"
+	Absent    VotePosition = "Absent"
+	Present   VotePosition = "Present"
+	NotVoting VotePosition = "Not Voting"
+	Recused   VotePosition = "Recused"
+)
+
+// NormalizeVotePosition maps the vote wording used by each legislature to a VotePosition
+func NormalizeVotePosition(s string) VotePosition {
+	s = strings.TrimSpace(s)
+	switch strings.ToLower(s) {
+	case "aye", "yea", "yes", "y", "affirmative", "aye with reservations", "ayewr":
+		return Aye
+	case "nay", "no", "n", "negative":
+		return Nay
+	case "excused", "exc", "er", "medical":
+		return Excused
+	case "absent", "abs":
+		return Absent
+	case "present":
+		return Present
+	case "not voting", "not-voting", "nv":
+		return NotVoting
+	case "recused", "conflict":
+		return Recused
+	}
+	return VotePosition(s)
+}
+
+type MemberVote struct {
+	Member   Member
+	Position VotePosition
+}
+
+// VoteTally is the count of votes in a RollCall
+type VoteTally struct {
+	Aye   int
+	Nay   int
+	Other int // excused, absent, present, etc
+}
+
+func (t VoteTally) String() string {
+	return fmt.Sprintf("%d-%d-%d", t.Aye, t.Nay, t.Other)
+}
+
+// TallyVotes counts the positions in votes
+func TallyVotes(votes []MemberVote) VoteTally {
+	var t VoteTally
+	for _, v := range votes {
+		switch v.Position {
+		case Aye:
+			t.Aye++
+		case Nay:
+			t.Nay++
+		default:
+			t.Other++
+		}
+	}
+	return t
+}
+
+// RollCall is a recorded vote on a piece of legislation in committee or on the floor
+type RollCall struct {
+	Date      time.Time
+	Chamber   string // i.e. "Senate", "Assembly" (empty for unicameral bodies)
+	Committee string // empty for a floor vote
+	Motion    string // i.e. "On Passage", "Approved by Committee"
+	Result    string // i.e. "Passed", "Failed"
+	Tally     VoteTally
+	Votes     []MemberVote
+}
+
+func (r RollCall) IsFloor() bool { return r.Committee == "" }
+
+// SortRollCalls orders roll calls from oldest to newest
+func SortRollCalls(r []RollCall) {
+	sort.SliceStable(r, func(i, j int) bool { return r[i].Date.Before(r[j].Date) })
+}
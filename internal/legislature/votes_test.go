@@ -0,0 +1,44 @@
+package legislature
+
+import (
+	"testing"
+)
+
+func TestNormalizeVotePosition(t *testing.T) {
+	type testCase struct {
+		in       string
+		expected VotePosition
+	}
+	tests := []testCase{
+		{"Affirmative", Aye},
+		{"Yea", Aye},
+		{"AYE", Aye},
+		{"Negative", Nay},
+		{"No", Nay},
+		{"Excused", Excused},
+		{"Not Voting", NotVoting},
+		{" Present ", Present},
+		{"Abstain", VotePosition("Abstain")},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			if got := NormalizeVotePosition(tc.in); got != tc.expected {
+				t.Errorf("got %q expected %q", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestTallyVotes(t *testing.T) {
+	votes := []MemberVote{
+		{Position: Aye},
+		{Position: Aye},
+		{Position: Nay},
+		{Position: Excused},
+		{Position: NotVoting},
+	}
+	got := TallyVotes(votes)
+	if expected := (VoteTally{Aye: 2, Nay: 1, Other: 2}); got != expected {
+		t.Errorf("got %v expected %v", got, expected)
+	}
+}
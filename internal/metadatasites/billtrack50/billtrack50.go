@@ -99,5 +99,4 @@ func parseOfficialDocument(r io.Reader) (*url.URL, error) {
 			}
 		}
 	}
-	return nil, nil
 }
@@ -0,0 +1,112 @@
+package clerkhouse
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jehiah/legislation.support/internal/resolvers/congress"
+	log "github.com/sirupsen/logrus"
+)
+
+// ClerkHouse maps clerk.house.gov roll call votes to the congress.gov URL of the bill voted on
+type ClerkHouse struct{}
+
+var (
+	// i.e. /Votes/2023100 (year and roll call number)
+	votePagePattern = regexp.MustCompile(`(?i)^/Votes/((?:19|20)[0-9]{2})([0-9]+)$`)
+	// i.e. /evs/2023/roll100.xml
+	evsPattern = regexp.MustCompile(`(?i)^/evs/((?:19|20)[0-9]{2})/roll([0-9]+)\.(?:xml|htm)$`)
+)
+
+func (c ClerkHouse) SupportedDomains() []string {
+	return []string{"clerk.house.gov"}
+}
+
+// Lookup matches clerk.house.gov roll call vote URLs
+//
+// Example: https://clerk.house.gov/Votes/2025190 => https://www.congress.gov/bill/119th-congress/house-bill/4
+func (c ClerkHouse) Lookup(ctx context.Context, u *url.URL) (*url.URL, error) {
+	switch u.Hostname() {
+	case "clerk.house.gov":
+	default:
+		return nil, nil
+	}
+	year, roll, ok := parseVotePath(u.Path)
+	if !ok {
+		return nil, nil
+	}
+	log.Infof("found clerk.house.gov URL %s", u.String())
+	return c.rollCallBill(ctx, year, roll)
+}
+
+// parseVotePath returns the year and roll call number from a vote URL path
+func parseVotePath(p string) (year, roll int, ok bool) {
+	m := votePagePattern.FindStringSubmatch(p)
+	if m == nil {
+		m = evsPattern.FindStringSubmatch(p)
+	}
+	if m == nil {
+		return 0, 0, false
+	}
+	year, _ = strconv.Atoi(m[1])
+	roll, _ = strconv.Atoi(m[2])
+	return year, roll, roll > 0
+}
+
+func (c ClerkHouse) rollCallBill(ctx context.Context, year, roll int) (*url.URL, error) {
+	u := fmt.Sprintf("https://clerk.house.gov/evs/%d/roll%03d.xml", year, roll)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "https://legislation.support/")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("got status %d for %s", resp.StatusCode, u)
+	}
+	return parseRollCall(resp.Body)
+}
+
+// parseRollCall returns the congress.gov URL for the bill in a roll call vote XML (or nil for procedural votes)
+func parseRollCall(r io.Reader) (*url.URL, error) {
+	var v congress.HouseRollCallVote
+	if err := xml.NewDecoder(r).Decode(&v); err != nil {
+		return nil, err
+	}
+	billType, number, ok := parseLegisNum(v.VoteMetadata.LegisNum)
+	if !ok || v.VoteMetadata.Congress == 0 {
+		return nil, nil
+	}
+	return congress.BillURL(v.VoteMetadata.Congress, billType, number), nil
+}
+
+// parseLegisNum parses a clerk legislation number. "H R 1234" => "hr", "1234"
+//
+// Procedural votes ("QUORUM", "JOURNAL") and amendments are not matched
+func parseLegisNum(s string) (billType, number string, ok bool) {
+	f := strings.Fields(s)
+	if len(f) < 2 {
+		return "", "", false
+	}
+	number = f[len(f)-1]
+	if _, err := strconv.Atoi(number); err != nil {
+		return "", "", false
+	}
+	billType = strings.ToLower(strings.Join(f[:len(f)-1], ""))
+	if !congress.IsBillType(billType) {
+		return "", "", false
+	}
+	return billType, number, true
+}
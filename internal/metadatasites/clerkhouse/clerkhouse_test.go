@@ -0,0 +1,76 @@
+package clerkhouse
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParseVotePath(t *testing.T) {
+	type testCase struct {
+		path       string
+		year, roll int
+		ok         bool
+	}
+	tests := []testCase{
+		{"/Votes/2025190", 2025, 190, true},
+		{"/Votes/20239", 2023, 9, true},
+		{"/evs/2023/roll100.xml", 2023, 100, true},
+		{"/evs/2023/roll009.xml", 2023, 9, true},
+		{"/Votes", 0, 0, false},
+		{"/Members/A000370", 0, 0, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			year, roll, ok := parseVotePath(tc.path)
+			if year != tc.year || roll != tc.roll || ok != tc.ok {
+				t.Errorf("got %d %d %v expected %d %d %v", year, roll, ok, tc.year, tc.roll, tc.ok)
+			}
+		})
+	}
+}
+
+func TestParseLegisNum(t *testing.T) {
+	type testCase struct {
+		in               string
+		billType, number string
+	}
+	tests := []testCase{
+		{"H R 1234", "hr", "1234"},
+		{"H RES 5", "hres", "5"},
+		{"H J RES 7", "hjres", "7"},
+		{"H CON RES 3", "hconres", "3"},
+		{"S 100", "s", "100"},
+		{"QUORUM", "", ""},
+		{"JOURNAL", "", ""},
+		{"H AMDT 12", "", ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			billType, number, _ := parseLegisNum(tc.in)
+			if billType != tc.billType || number != tc.number {
+				t.Errorf("got %q %q expected %q %q", billType, number, tc.billType, tc.number)
+			}
+		})
+	}
+}
+
+func TestParseRollCall(t *testing.T) {
+	f, err := os.Open("testdata/roll190.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	u, err := parseRollCall(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u == nil || u.String() != "https://www.congress.gov/bill/119th-congress/house-bill/4" {
+		t.Fatalf("unexpected url %s", u)
+	}
+
+	u, err = parseRollCall(strings.NewReader(`<rollcall-vote><vote-metadata><congress>119</congress><legis-num>QUORUM</legis-num></vote-metadata></rollcall-vote>`))
+	if err != nil || u != nil {
+		t.Errorf("got %v %v expected no match", u, err)
+	}
+}
@@ -0,0 +1,63 @@
+package congressgov
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	"github.com/jehiah/legislation.support/internal/resolvers/congress"
+	log "github.com/sirupsen/logrus"
+)
+
+// CongressGov maps congress.gov bill sub-pages (text, actions, cosponsors), short links,
+// bill text files and api.congress.gov URLs to the canonical congress.gov bill URL
+type CongressGov struct{}
+
+var (
+	// i.e. /bill/118th-congress/house-bill/1234/text or /bill/121st-congress/senate-bill/5
+	billPagePattern = regexp.MustCompile(`(?i)^/bill/([0-9]+)(?:st|nd|rd|th)-congress/([a-z-]+)/([0-9]+)(/.*)?$`)
+	// i.e. /bill/118/hr/1234 (congress.gov) or /v3/bill/118/hr/1234/actions (api.congress.gov)
+	shortPattern = regexp.MustCompile(`(?i)^(?:/v3)?/bill/([0-9]+)/([a-z]+)/([0-9]+)(/.*)?$`)
+	// i.e. /118/bills/hr1234/BILLS-118hr1234ih.pdf
+	textPattern = regexp.MustCompile(`(?i)^/([0-9]+)/bills/([a-z]+)([0-9]+)(/.*)?$`)
+)
+
+func (c CongressGov) SupportedDomains() []string {
+	return []string{"api.congress.gov"}
+}
+
+// Lookup matches congress.gov and api.congress.gov bill URLs
+//
+// Examples:
+// https://www.congress.gov/bill/118th-congress/house-bill/1234/text?s=1 => https://www.congress.gov/bill/118th-congress/house-bill/1234
+// https://www.congress.gov/118/bills/hr1234/BILLS-118hr1234ih.pdf => https://www.congress.gov/bill/118th-congress/house-bill/1234
+// https://api.congress.gov/v3/bill/118/hr/1234 => https://www.congress.gov/bill/118th-congress/house-bill/1234
+func (c CongressGov) Lookup(ctx context.Context, u *url.URL) (*url.URL, error) {
+	var n, billType, number string
+	switch u.Hostname() {
+	case "congress.gov", "www.congress.gov":
+		if p := billPagePattern.FindStringSubmatch(u.Path); p != nil {
+			n, billType, number = p[1], congress.BillTypeNameToCode(p[2]), p[3]
+		} else if p := shortPattern.FindStringSubmatch(u.Path); p != nil {
+			n, billType, number = p[1], p[2], p[3]
+		} else if p := textPattern.FindStringSubmatch(u.Path); p != nil {
+			n, billType, number = p[1], p[2], p[3]
+		}
+	case "api.congress.gov":
+		if p := shortPattern.FindStringSubmatch(u.Path); p != nil {
+			n, billType, number = p[1], p[2], p[3]
+		}
+	default:
+		return nil, nil
+	}
+	if n == "" || !congress.IsBillType(billType) {
+		return nil, nil
+	}
+	congressNum, err := strconv.Atoi(n)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("found congress.gov URL %s", u.String())
+	return congress.BillURL(congressNum, billType, number), nil
+}
@@ -0,0 +1,49 @@
+package congressgov
+
+import (
+	"context"
+	"net/url"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	type testCase struct {
+		u        string
+		expected string
+	}
+	tests := []testCase{
+		{"https://www.congress.gov/bill/118th-congress/house-bill/1234", "https://www.congress.gov/bill/118th-congress/house-bill/1234"},
+		{"https://www.congress.gov/bill/118th-congress/house-bill/1234/text?s=4&r=1", "https://www.congress.gov/bill/118th-congress/house-bill/1234"},
+		{"https://www.congress.gov/bill/117th-congress/senate-bill/874/cosponsors", "https://www.congress.gov/bill/117th-congress/senate-bill/874"},
+		{"https://congress.gov/bill/119th-congress/house-joint-resolution/7/all-actions", "https://www.congress.gov/bill/119th-congress/house-joint-resolution/7"},
+		{"https://www.congress.gov/bill/118/hr/1234", "https://www.congress.gov/bill/118th-congress/house-bill/1234"},
+		{"https://www.congress.gov/118/bills/hr1234/BILLS-118hr1234ih.pdf", "https://www.congress.gov/bill/118th-congress/house-bill/1234"},
+		{"https://www.congress.gov/118/bills/sres12/BILLS-118sres12ats.htm", "https://www.congress.gov/bill/118th-congress/senate-resolution/12"},
+		{"https://api.congress.gov/v3/bill/118/hr/1234?format=json", "https://www.congress.gov/bill/118th-congress/house-bill/1234"},
+		{"https://api.congress.gov/v3/bill/118/s/874/actions", "https://www.congress.gov/bill/118th-congress/senate-bill/874"},
+		{"https://www.congress.gov/committee/house-judiciary/hsju00", ""},
+		{"https://www.congress.gov/amendment/118th-congress/house-amendment/12", ""},
+		{"https://www.congress.gov/bill/118th-congress/unknown-bill/12", ""},
+		{"https://api.congress.gov/v3/member/S000148", ""},
+		{"https://example.com/bill/118/hr/1234", ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.u, func(t *testing.T) {
+			u, err := url.Parse(tc.u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := CongressGov{}.Lookup(context.Background(), u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotURL string
+			if got != nil {
+				gotURL = got.String()
+			}
+			if gotURL != tc.expected {
+				t.Errorf("got %q expected %q", gotURL, tc.expected)
+			}
+		})
+	}
+}
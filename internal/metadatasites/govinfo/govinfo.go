@@ -0,0 +1,49 @@
+package govinfo
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	"github.com/jehiah/legislation.support/internal/resolvers/congress"
+	log "github.com/sirupsen/logrus"
+)
+
+// GovInfo maps govinfo.gov bill text packages to the canonical congress.gov URL
+type GovInfo struct{}
+
+var (
+	// package ID i.e. BILLS-118hr1234ih in /app/details/BILLS-118hr1234ih or /content/pkg/BILLS-118hr1234ih/pdf/BILLS-118hr1234ih.pdf
+	packagePattern = regexp.MustCompile(`(?i)^/(?:app/details|content/pkg)/BILLS-([0-9]+)([a-z]+?)([0-9]+)[a-z]*(/.*)?$`)
+	// i.e. /link/bills/118/hr/1234
+	linkPattern = regexp.MustCompile(`(?i)^/link/bills/([0-9]+)/([a-z]+)/([0-9]+)(/.*)?$`)
+)
+
+func (g GovInfo) SupportedDomains() []string {
+	return []string{"govinfo.gov"}
+}
+
+// Lookup matches govinfo.gov bill URLs
+//
+// Example: https://www.govinfo.gov/app/details/BILLS-118hr1234ih => https://www.congress.gov/bill/118th-congress/house-bill/1234
+func (g GovInfo) Lookup(ctx context.Context, u *url.URL) (*url.URL, error) {
+	switch u.Hostname() {
+	case "govinfo.gov", "www.govinfo.gov":
+	default:
+		return nil, nil
+	}
+	p := packagePattern.FindStringSubmatch(u.Path)
+	if p == nil {
+		p = linkPattern.FindStringSubmatch(u.Path)
+	}
+	if p == nil || !congress.IsBillType(p[2]) {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(p[1])
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("found govinfo URL %s", u.String())
+	return congress.BillURL(n, p[2], p[3]), nil
+}
@@ -0,0 +1,43 @@
+package govinfo
+
+import (
+	"context"
+	"net/url"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	type testCase struct {
+		u        string
+		expected string
+	}
+	tests := []testCase{
+		{"https://www.govinfo.gov/app/details/BILLS-118hr1234ih", "https://www.congress.gov/bill/118th-congress/house-bill/1234"},
+		{"https://www.govinfo.gov/content/pkg/BILLS-118hr1234ih/pdf/BILLS-118hr1234ih.pdf", "https://www.congress.gov/bill/118th-congress/house-bill/1234"},
+		{"https://www.govinfo.gov/content/pkg/BILLS-117s874enr/html/BILLS-117s874enr.htm", "https://www.congress.gov/bill/117th-congress/senate-bill/874"},
+		{"https://www.govinfo.gov/app/details/BILLS-119hjres7eh", "https://www.congress.gov/bill/119th-congress/house-joint-resolution/7"},
+		{"https://www.govinfo.gov/link/bills/118/hr/1234?link-type=pdf", "https://www.congress.gov/bill/118th-congress/house-bill/1234"},
+		{"https://www.govinfo.gov/app/details/PLAW-118publ5", ""},
+		{"https://www.govinfo.gov/app/details/CRPT-118hrpt100", ""},
+		{"https://example.com/app/details/BILLS-118hr1234ih", ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.u, func(t *testing.T) {
+			u, err := url.Parse(tc.u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := GovInfo{}.Lookup(context.Background(), u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotURL string
+			if got != nil {
+				gotURL = got.String()
+			}
+			if gotURL != tc.expected {
+				t.Errorf("got %q expected %q", gotURL, tc.expected)
+			}
+		})
+	}
+}
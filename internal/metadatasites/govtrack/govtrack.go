@@ -0,0 +1,42 @@
+package govtrack
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	"github.com/jehiah/legislation.support/internal/resolvers/congress"
+	log "github.com/sirupsen/logrus"
+)
+
+// GovTrack maps govtrack.us bill pages to the canonical congress.gov URL
+type GovTrack struct{}
+
+// i.e. /congress/bills/118/hr1234 or /congress/bills/118/hr1234/text
+var billPattern = regexp.MustCompile(`(?i)^/congress/bills/([0-9]+)/([a-z]+)([0-9]+)(/.*)?$`)
+
+func (g GovTrack) SupportedDomains() []string {
+	return []string{"govtrack.us"}
+}
+
+// Lookup matches govtrack.us bill URLs
+//
+// Example: https://www.govtrack.us/congress/bills/118/hr1234/text => https://www.congress.gov/bill/118th-congress/house-bill/1234
+func (g GovTrack) Lookup(ctx context.Context, u *url.URL) (*url.URL, error) {
+	switch u.Hostname() {
+	case "govtrack.us", "www.govtrack.us":
+	default:
+		return nil, nil
+	}
+	p := billPattern.FindStringSubmatch(u.Path)
+	if p == nil || !congress.IsBillType(p[2]) {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(p[1])
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("found govtrack URL %s", u.String())
+	return congress.BillURL(n, p[2], p[3]), nil
+}
@@ -0,0 +1,44 @@
+package govtrack
+
+import (
+	"context"
+	"net/url"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	type testCase struct {
+		u        string
+		expected string
+	}
+	tests := []testCase{
+		{"https://www.govtrack.us/congress/bills/118/hr1234", "https://www.congress.gov/bill/118th-congress/house-bill/1234"},
+		{"https://www.govtrack.us/congress/bills/118/hr1234/text", "https://www.congress.gov/bill/118th-congress/house-bill/1234"},
+		{"https://govtrack.us/congress/bills/117/s874/summary#oneliner", "https://www.congress.gov/bill/117th-congress/senate-bill/874"},
+		{"https://www.govtrack.us/congress/bills/119/hjres7", "https://www.congress.gov/bill/119th-congress/house-joint-resolution/7"},
+		{"https://www.govtrack.us/congress/bills/118/sconres12/details", "https://www.congress.gov/bill/118th-congress/senate-concurrent-resolution/12"},
+		{"https://www.govtrack.us/congress/bills/118/xyz12", ""},
+		{"https://www.govtrack.us/congress/votes/118-2023/h100", ""},
+		{"https://www.govtrack.us/congress/members/charles_schumer/300087", ""},
+		{"https://example.com/congress/bills/118/hr1234", ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.u, func(t *testing.T) {
+			u, err := url.Parse(tc.u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := GovTrack{}.Lookup(context.Background(), u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotURL string
+			if got != nil {
+				gotURL = got.String()
+			}
+			if gotURL != tc.expected {
+				t.Errorf("got %q expected %q", gotURL, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package legiscan
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// LegiScan maps legiscan.com bill pages for New York to the canonical nysenate.gov URL.
+//
+// Bills in other states are handled by the LegiScan resolver
+type LegiScan struct{}
+
+// i.e. /NY/bill/S01046/2021
+var nyBillPattern = regexp.MustCompile(`(?i)^/NY/bill/([SA])0*([0-9]+)([A-Z]?)/((19|20)[0-9]{2})(\.json)?$`)
+
+func (l LegiScan) SupportedDomains() []string {
+	return []string{"legiscan.com"}
+}
+
+// Lookup matches legiscan.com NY bill URLs
+//
+// Example: https://legiscan.com/NY/bill/S01046/2021 => https://www.nysenate.gov/legislation/bills/2021/S1046
+func (l LegiScan) Lookup(ctx context.Context, u *url.URL) (*url.URL, error) {
+	switch u.Hostname() {
+	case "legiscan.com", "www.legiscan.com":
+	default:
+		return nil, nil
+	}
+	p := nyBillPattern.FindStringSubmatch(u.Path)
+	if p == nil {
+		return nil, nil
+	}
+	year, err := strconv.Atoi(p[4])
+	if err != nil {
+		return nil, err
+	}
+	// NY sessions start in odd years
+	if year%2 == 0 {
+		year -= 1
+	}
+	printNo := strings.ToUpper(p[1]) + p[2] + strings.ToUpper(p[3])
+	log.Infof("found legiscan URL %s", u.String())
+	return &url.URL{
+		Scheme: "https",
+		Host:   "www.nysenate.gov",
+		Path:   fmt.Sprintf("/legislation/bills/%d/%s", year, printNo),
+	}, nil
+}
@@ -0,0 +1,42 @@
+package legiscan
+
+import (
+	"context"
+	"net/url"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	type testCase struct {
+		u        string
+		expected string
+	}
+	tests := []testCase{
+		{"https://legiscan.com/NY/bill/S01046/2021", "https://www.nysenate.gov/legislation/bills/2021/S1046"},
+		{"https://legiscan.com/NY/bill/S01046/2021.json", "https://www.nysenate.gov/legislation/bills/2021/S1046"},
+		{"https://legiscan.com/NY/bill/A06141/2024", "https://www.nysenate.gov/legislation/bills/2023/A6141"},
+		{"https://legiscan.com/ny/bill/a6141b/2023", "https://www.nysenate.gov/legislation/bills/2023/A6141B"},
+		{"https://legiscan.com/NJ/bill/S1234/2024", ""},
+		{"https://legiscan.com/NY/text/S01046/2021", ""},
+		{"https://example.com/NY/bill/S01046/2021", ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.u, func(t *testing.T) {
+			u, err := url.Parse(tc.u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := LegiScan{}.Lookup(context.Background(), u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotURL string
+			if got != nil {
+				gotURL = got.String()
+			}
+			if gotURL != tc.expected {
+				t.Errorf("got %q expected %q", gotURL, tc.expected)
+			}
+		})
+	}
+}
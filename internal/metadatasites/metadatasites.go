@@ -6,10 +6,20 @@ import (
 
 	"github.com/jehiah/legislation.support/internal/legislature"
 	"github.com/jehiah/legislation.support/internal/metadatasites/billtrack50"
+	"github.com/jehiah/legislation.support/internal/metadatasites/clerkhouse"
+	"github.com/jehiah/legislation.support/internal/metadatasites/congressgov"
+	"github.com/jehiah/legislation.support/internal/metadatasites/govinfo"
+	"github.com/jehiah/legislation.support/internal/metadatasites/govtrack"
+	"github.com/jehiah/legislation.support/internal/metadatasites/legiscan"
 )
 
 var Sites = legislature.MetadataSites{
 	billtrack50.BillTrack50{},
+	legiscan.LegiScan{},
+	govtrack.GovTrack{},
+	congressgov.CongressGov{},
+	govinfo.GovInfo{},
+	clerkhouse.ClerkHouse{},
 }
 
 func Lookup(ctx context.Context, u *url.URL) (*url.URL, error) {
@@ -0,0 +1,138 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+	"github.com/jehiah/legislation.support/internal/metadatasites"
+	log "github.com/sirupsen/logrus"
+)
+
+// ParseCitation returns the body and legislation ID for a citation like "S1234", "H.R. 5" or "Int 123-2024".
+//
+// Each resolver that implements legislature.CitationParser is tried in order. A citation may start
+// with a body to select it (i.e. "US S. 874", "us-senate S874" or "NY S1234")
+func ParseCitation(citation string, session legislature.Session) (legislature.GlobalID, bool) {
+	citation = strings.TrimSpace(citation)
+	bodies := func(legislature.Body) bool { return true }
+	if prefix, rest, ok := strings.Cut(citation, " "); ok && isBodyPrefix(prefix) {
+		citation = strings.TrimSpace(rest)
+		bodies = func(b legislature.Body) bool { return matchesBodyPrefix(b, prefix) }
+	}
+	for _, r := range Resolvers {
+		p, ok := r.(legislature.CitationParser)
+		if !ok || !bodies(r.Body()) {
+			continue
+		}
+		if id, ok := p.ParseCitation(citation, session); ok {
+			return legislature.GlobalID{BodyID: r.Body().ID, LegislationID: id}, true
+		}
+	}
+	return legislature.GlobalID{}, false
+}
+
+// matchesBodyPrefix matches the Body ID, DisplayID or the start of the DisplayID (i.e. "NY" for "NY-Senate")
+func matchesBodyPrefix(b legislature.Body, prefix string) bool {
+	short, _, _ := strings.Cut(b.DisplayID, "-")
+	for _, s := range []string{string(b.ID), b.DisplayID, short} {
+		if s != "" && strings.EqualFold(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isBodyPrefix(prefix string) bool {
+	for _, b := range Bodies {
+		if matchesBodyPrefix(b, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// LookupCitation parses a citation (see ParseCitation) and fetches the legislation
+func LookupCitation(ctx context.Context, citation string) (*legislature.Legislation, error) {
+	id, ok := ParseCitation(citation, legislature.Session{})
+	if !ok {
+		return nil, fmt.Errorf("unrecognized citation %q", citation)
+	}
+	if !IsAvailable(id.BodyID) {
+		return nil, fmt.Errorf("%s is unavailable", Bodies[id.BodyID].Name)
+	}
+	return Resolvers.Find(id.BodyID).Refresh(ctx, id.LegislationID)
+}
+
+// LookupInput finds legislation from a URL or a citation (i.e. "S1234" or "H.R. 5"). A URL for a
+// metadata site (see metadatasites) is first mapped to the legislature's URL
+func LookupInput(ctx context.Context, input string) (*legislature.Legislation, error) {
+	if !IsURL(input) {
+		log.WithContext(ctx).WithField("citation", input).Infof("looking up citation")
+		bill, err := LookupCitation(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if bill == nil {
+			return nil, fmt.Errorf("legislation matching %q not found", input)
+		}
+		return bill, nil
+	}
+	u, err := url.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	logFields := log.Fields{"legislation_url": u.String()}
+	log.WithContext(ctx).WithFields(logFields).Infof("parsed URL host:%s", u.Hostname())
+	matchedURL, err := metadatasites.Lookup(ctx, u)
+	if err != nil {
+		log.WithContext(ctx).WithFields(logFields).Errorf("metadatasites.Lookup error %#v", err)
+	}
+	if matchedURL.Hostname() != u.Hostname() {
+		log.WithContext(ctx).WithFields(logFields).Infof("metadatasites found URL %q", matchedURL)
+	}
+	bill, err := Lookup(ctx, matchedURL)
+	if err != nil {
+		return nil, err
+	}
+	if bill == nil {
+		return nil, fmt.Errorf("legislation matching url %q not found", u)
+	}
+	return bill, nil
+}
+
+// IsURL is true for http(s) input (and false for a citation)
+func IsURL(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
+// SplitInput splits a pasted list of URLs and citations. Entries are separated by
+// new lines, commas or semicolons; URLs may also be separated by spaces.
+//
+// "https://a https://b\nS1234, H.R. 5" => ["https://a", "https://b", "S1234", "H.R. 5"]
+func SplitInput(s string) []string {
+	var out []string
+	for _, line := range strings.FieldsFunc(s, func(r rune) bool { return r == '\n' || r == '\r' || r == ';' }) {
+		var citation []string
+		flush := func() {
+			for _, c := range strings.Split(strings.Join(citation, " "), ",") {
+				if c = strings.TrimSpace(c); c != "" {
+					out = append(out, c)
+				}
+			}
+			citation = nil
+		}
+		for _, f := range strings.Fields(line) {
+			if IsURL(f) {
+				flush()
+				out = append(out, strings.TrimSuffix(f, ","))
+				continue
+			}
+			citation = append(citation, f)
+		}
+		flush()
+	}
+	return out
+}
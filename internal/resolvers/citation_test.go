@@ -0,0 +1,106 @@
+package resolvers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+func TestParseCitation(t *testing.T) {
+	session := legislature.Session{StartYear: 2023, EndYear: 2024}
+	type testCase struct {
+		in       string
+		expected string
+	}
+	tests := []testCase{
+		{"S1234", "nysenate.2023-S1234"},
+		{"s1234a", "nysenate.2023-S1234"},
+		{"S.1234", "nysenate.2023-S1234"},
+		{"A.4567", "ny-assembly.2023-A4567"},
+		{"A 4567", "ny-assembly.2023-A4567"},
+		{"2021-S520", "nysenate.2021-S520"},
+		{"H.R. 5", "us-house.118-HR5"},
+		{"hr5", "us-house.118-HR5"},
+		{"H.Res. 12", "us-house.118-HRES12"},
+		{"S. 874", "us-senate.118-S874"},
+		{"US S874", "us-senate.118-S874"},
+		{"us-senate S. 874", "us-senate.118-S874"},
+		{"NY S874", "nysenate.2023-S874"},
+		{"senate-bill 874", "us-senate.118-S874"},
+		{"119-HJRES7", "us-house.119-HJRES7"},
+		{"Int 123-2024", "nyc.0123-2024"},
+		{"Int. 0123-2024", "nyc.0123-2024"},
+		{"intro 5-2022", "nyc.0005-2022"},
+		{"Res 12-2024", "nyc.res-0012-2024"},
+		{"1141-2018", "nyc.1141-2018"},
+		{"NYC 1141-2018", "nyc.1141-2018"},
+		{"US A4567", ""},
+		{"Int 123", ""},
+		{"S0", ""},
+		{"clean air", ""},
+		{"", ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			got, ok := ParseCitation(tc.in, session)
+			var gotS string
+			if ok {
+				gotS = got.String()
+			}
+			if gotS != tc.expected {
+				t.Errorf("got %q expected %q", gotS, tc.expected)
+			}
+		})
+	}
+}
+
+func TestLookupCitationUnavailable(t *testing.T) {
+	if IsAvailable("nysenate") {
+		t.Skip("NY_SENATE_TOKEN set")
+	}
+	_, err := LookupCitation(context.Background(), "S1234")
+	if err == nil || !strings.Contains(err.Error(), "unavailable") {
+		t.Errorf("got %v expected unavailable error", err)
+	}
+	_, err = LookupCitation(context.Background(), "clean air")
+	if err == nil || !strings.Contains(err.Error(), "unrecognized") {
+		t.Errorf("got %v expected unrecognized error", err)
+	}
+}
+
+func TestLookupInputNotFound(t *testing.T) {
+	ctx := context.Background()
+	for input, expected := range map[string]string{
+		"clean air":                 "unrecognized",
+		"https://example.com/S1234": "not found",
+		"https://%zz":               "invalid URL escape",
+	} {
+		_, err := LookupInput(ctx, input)
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("%q got %v expected %q error", input, err, expected)
+		}
+	}
+}
+
+func TestSplitInput(t *testing.T) {
+	type testCase struct {
+		in       string
+		expected []string
+	}
+	tests := []testCase{
+		{"https://a https://b", []string{"https://a", "https://b"}},
+		{"https://a\nS1234, H.R. 5", []string{"https://a", "S1234", "H.R. 5"}},
+		{"Int 123-2024; A.4567\r\n\n https://a, S. 874", []string{"Int 123-2024", "A.4567", "https://a", "S. 874"}},
+		{"  ", nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			got := SplitInput(tc.in)
+			if strings.Join(got, "|") != strings.Join(tc.expected, "|") {
+				t.Errorf("got %q expected %q", got, tc.expected)
+			}
+		})
+	}
+}
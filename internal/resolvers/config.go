@@ -0,0 +1,353 @@
+package resolvers
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+	"github.com/jehiah/legislation.support/internal/resolvers/congress"
+	"github.com/jehiah/legislation.support/internal/resolvers/legiscan"
+	"github.com/jehiah/legislation.support/internal/resolvers/legistar"
+	"github.com/jehiah/legislation.support/internal/resolvers/nyc"
+	"github.com/jehiah/legislation.support/internal/resolvers/nysenate"
+	"github.com/jehiah/legislation.support/internal/resolvers/openstates"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:embed default_config.json
+var defaultConfig []byte
+
+// Config lists the bodies (and the resolver for each) that are enabled
+type Config struct {
+	Bodies   []BodyConfig    `json:"bodies"`
+	LegiScan *LegiScanConfig `json:"legiscan,omitempty"`
+}
+
+// BodyConfig describes a legislature.Body and how legislation is resolved for it
+type BodyConfig struct {
+	ID         legislature.BodyID `json:"id"`
+	DisplayID  string             `json:"display_id"`
+	Name       string             `json:"name"`
+	Location   string             `json:"location"`
+	URL        string             `json:"url"`
+	MemberName string             `json:"member_name"`
+	Bicameral  legislature.BodyID `json:"bicameral,omitempty"`
+	UpperHouse bool               `json:"upper_house,omitempty"`
+
+	// Resolver is one of nyc, nysenate, ny-assembly, us-house, us-senate, legistar, legiscan, openstates
+	Resolver string `json:"resolver"`
+	// APIKey (or the environment variable APIKeyEnv) is the credential for the resolver
+	APIKey    string `json:"api_key,omitempty"`
+	APIKeyEnv string `json:"api_key_env,omitempty"`
+
+	// Calendar changes how regular sessions are generated and Sessions adds explicit
+	// regular or special sessions to the calendar (nyc, nysenate, ny-assembly, legistar)
+	Calendar *CalendarConfig `json:"calendar,omitempty"`
+	Sessions []SessionConfig `json:"sessions,omitempty"`
+
+	// State is the two letter state (legiscan, openstates)
+	State string `json:"state,omitempty"`
+	// Chamber is "upper" or "lower" (openstates)
+	Chamber string `json:"chamber,omitempty"`
+	// DataDir is a local Open States bulk data directory used instead of the API (openstates)
+	// or a nyc_legislation checkout used for search (nyc)
+	DataDir    string `json:"data_dir,omitempty"`
+	DataDirEnv string `json:"data_dir_env,omitempty"`
+
+	// Legistar client settings (legistar)
+	LegistarClient   string `json:"legistar_client,omitempty"`
+	LegistarHost     string `json:"legistar_host,omitempty"`
+	LegistarBodyName string `json:"legistar_body_name,omitempty"`
+}
+
+// CalendarConfig generates regular sessions; see legislature.Calendar
+type CalendarConfig struct {
+	FirstYear    int    `json:"first_year"`
+	Lengths      []int  `json:"session_lengths"`
+	Convene      string `json:"convene,omitempty"` // month and day i.e. "01-03"
+	LameDuckDays int    `json:"lame_duck_days,omitempty"`
+}
+
+// SessionConfig is a session. Start and End are optional dates i.e. "2024-01-03";
+// by default a session runs until the next session convenes
+type SessionConfig struct {
+	StartYear int    `json:"start_year"`
+	EndYear   int    `json:"end_year"`
+	Start     string `json:"start,omitempty"`
+	End       string `json:"end,omitempty"`
+	Special   bool   `json:"special,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+// LegiScanConfig adds a body for each state legislature using LegiScan
+type LegiScanConfig struct {
+	APIKey    string   `json:"api_key,omitempty"`
+	APIKeyEnv string   `json:"api_key_env,omitempty"`
+	States    []string `json:"states"`  // "*" for all states
+	Exclude   []string `json:"exclude"` // states resolved elsewhere (i.e. "NY")
+}
+
+func apiKey(key, env string) string {
+	if key == "" && env != "" {
+		return os.Getenv(env)
+	}
+	return key
+}
+
+func (c BodyConfig) key() string { return apiKey(c.APIKey, c.APIKeyEnv) }
+
+// calendar applies the configured calendar and sessions to base
+func (c BodyConfig) calendar(base legislature.Calendar) (legislature.Calendar, error) {
+	if cc := c.Calendar; cc != nil {
+		base.FirstYear, base.Lengths = cc.FirstYear, cc.Lengths
+		base.ConveneMonth, base.ConveneDay = 0, 0
+		if cc.Convene != "" {
+			t, err := time.Parse("01-02", cc.Convene)
+			if err != nil {
+				return base, fmt.Errorf("body %q invalid calendar convene %q", c.ID, cc.Convene)
+			}
+			base.ConveneMonth, base.ConveneDay = t.Month(), t.Day()
+		}
+		base.LameDuck = time.Duration(cc.LameDuckDays) * 24 * time.Hour
+	}
+	if len(c.Sessions) == 0 {
+		return base, nil
+	}
+	// listed sessions take precedence over the defaults
+	terms := make([]legislature.Term, 0, len(c.Sessions)+len(base.Terms))
+	for _, s := range c.Sessions {
+		if s.StartYear == 0 || s.EndYear < s.StartYear {
+			return base, fmt.Errorf("body %q invalid session %d-%d", c.ID, s.StartYear, s.EndYear)
+		}
+		t := legislature.Term{
+			Session: legislature.Session{StartYear: s.StartYear, EndYear: s.EndYear},
+			Name:    s.Name,
+			Special: s.Special,
+		}
+		var err error
+		if s.Start != "" {
+			if t.Start, err = time.Parse("2006-01-02", s.Start); err != nil {
+				return base, fmt.Errorf("body %q session %s invalid start %q", c.ID, t.Session, s.Start)
+			}
+		}
+		if s.End != "" {
+			if t.End, err = time.Parse("2006-01-02", s.End); err != nil {
+				return base, fmt.Errorf("body %q session %s invalid end %q", c.ID, t.Session, s.End)
+			}
+		}
+		if t.Special && (t.Start.IsZero() || t.End.IsZero()) {
+			return base, fmt.Errorf("body %q special session %s requires a start and end", c.ID, t.Session)
+		}
+		terms = append(terms, t)
+	}
+	for _, t := range base.Terms {
+		if !slices.ContainsFunc(terms, func(tt legislature.Term) bool { return tt.Session == t.Session && tt.Special == t.Special }) {
+			terms = append(terms, t)
+		}
+	}
+	base.Terms = terms
+	return base, nil
+}
+
+func (c BodyConfig) Body() legislature.Body {
+	b := legislature.Body{
+		ID:         c.ID,
+		DisplayID:  c.DisplayID,
+		Name:       c.Name,
+		Location:   c.Location,
+		URL:        c.URL,
+		MemberName: c.MemberName,
+		Bicameral:  c.Bicameral,
+		UpperHouse: c.UpperHouse,
+		Sort:       legislature.GenericLegislationSort,
+	}
+	switch c.Resolver {
+	case "nysenate", "ny-assembly":
+		b.Sort = nysenate.LegislationSort
+	}
+	return b
+}
+
+// ReadConfig reads a JSON config file. An empty path returns the default configuration
+func ReadConfig(path string) (*Config, error) {
+	data := defaultConfig
+	if path != "" {
+		var err error
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var c Config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("invalid config %q %w", path, err)
+	}
+	return &c, nil
+}
+
+// Build creates the resolver for each body.
+//
+// Resolvers that are missing credentials are disabled and their body is marked Unavailable
+func (c Config) Build() (legislature.Resolvers, map[legislature.BodyID]legislature.Body, error) {
+	var out legislature.Resolvers
+	bodies := make(map[legislature.BodyID]legislature.Body)
+	add := func(b legislature.Body, r legislature.Resolver, missingKey bool) {
+		if missingKey {
+			log.WithField("body", b.ID).Warnf("%s disabled: missing API key", b.Name)
+			b.Unavailable = true
+			r = disabled{Resolver: r, body: b}
+		}
+		out = append(out, r)
+		bodies[b.ID] = b
+	}
+	// each state legislature can only be resolved one way (i.e. New Jersey with Open States or with LegiScan)
+	// so that a bill isn't bookmarked under two bodies
+	states := make(map[string]string)
+	claim := func(id legislature.BodyID, state, resolver string) error {
+		state = strings.ToUpper(state)
+		if r, ok := states[state]; ok && r != resolver {
+			return fmt.Errorf("body %q state %s is already configured with resolver %q", id, state, r)
+		}
+		states[state] = resolver
+		return nil
+	}
+
+	for _, bc := range c.Bodies {
+		if bc.ID == "" {
+			return nil, nil, fmt.Errorf("body missing id")
+		}
+		if _, ok := bodies[bc.ID]; ok {
+			return nil, nil, fmt.Errorf("duplicate body %q", bc.ID)
+		}
+		b := bc.Body()
+		key := bc.key()
+		switch bc.Resolver {
+		case "nyc":
+			cal, err := bc.calendar(nyc.DefaultCalendar)
+			if err != nil {
+				return nil, nil, err
+			}
+			b.Calendar = &cal
+			n := nyc.New(b)
+			if dir := apiKey(bc.DataDir, bc.DataDirEnv); dir != "" {
+				n = n.WithSearchIndex(dir)
+			}
+			add(b, n, false)
+		case "nysenate", "ny-assembly":
+			if err := claim(b.ID, "NY", "nysenate"); err != nil {
+				return nil, nil, err
+			}
+			cal, err := bc.calendar(nysenate.DefaultCalendar)
+			if err != nil {
+				return nil, nil, err
+			}
+			b.Calendar = &cal
+			if bc.Resolver == "nysenate" {
+				add(b, nysenate.NewNYSenate(b, key), key == "")
+			} else {
+				add(b, nysenate.NewNYAssembly(b, key), key == "")
+			}
+		case "us-house":
+			b.Calendar = &congress.Calendar
+			add(b, congress.NewHouse(b, key), key == "")
+		case "us-senate":
+			b.Calendar = &congress.Calendar
+			add(b, congress.NewSenate(b, key), key == "")
+		case "legistar":
+			cal, err := bc.calendar(legislature.Calendar{})
+			if err != nil {
+				return nil, nil, err
+			}
+			b.Calendar = &cal
+			add(b, legistar.New(b, legistar.Config{
+				Client:   bc.LegistarClient,
+				Token:    key,
+				Host:     bc.LegistarHost,
+				BodyName: bc.LegistarBodyName,
+				Calendar: cal,
+			}), false)
+		case "legiscan":
+			add(b, legiscan.New(b, bc.State, key), key == "")
+		case "openstates":
+			if err := claim(b.ID, bc.State, "openstates"); err != nil {
+				return nil, nil, err
+			}
+			var source openstates.Source = openstates.APISource{Key: key}
+			dir := apiKey(bc.DataDir, bc.DataDirEnv)
+			if dir != "" {
+				source = openstates.DirSource(dir)
+			}
+			switch bc.Chamber {
+			case "upper":
+				add(b, openstates.NewUpper(b, bc.State, source), dir == "" && key == "")
+			case "lower":
+				add(b, openstates.NewLower(b, bc.State, source), dir == "" && key == "")
+			default:
+				return nil, nil, fmt.Errorf("body %q unknown openstates chamber %q", bc.ID, bc.Chamber)
+			}
+		default:
+			return nil, nil, fmt.Errorf("body %q unknown resolver %q", bc.ID, bc.Resolver)
+		}
+	}
+
+	if c.LegiScan != nil {
+		key := apiKey(c.LegiScan.APIKey, c.LegiScan.APIKeyEnv)
+		for _, s := range c.LegiScan.states() {
+			b := LegiScanBody(s)
+			if _, ok := bodies[b.ID]; ok {
+				continue
+			}
+			if err := claim(b.ID, s.ID, "legiscan"); err != nil {
+				return nil, nil, err
+			}
+			add(b, legiscan.New(b, s.ID, key), key == "")
+		}
+	}
+
+	for _, b := range bodies {
+		if b.Bicameral == "" {
+			continue
+		}
+		if _, ok := bodies[b.Bicameral]; !ok {
+			return nil, nil, fmt.Errorf("body %q bicameral %q not configured", b.ID, b.Bicameral)
+		}
+	}
+	return out, bodies, nil
+}
+
+func (c LegiScanConfig) states() []congress.State {
+	exclude := make(map[string]bool)
+	for _, s := range c.Exclude {
+		exclude[strings.ToUpper(s)] = true
+	}
+	include := make(map[string]bool)
+	for _, s := range c.States {
+		include[strings.ToUpper(s)] = true
+	}
+	var out []congress.State
+	for _, s := range congress.States {
+		if exclude[s.ID] || !(include["*"] || include[s.ID]) {
+			continue
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+// LegiScanBody is a state legislature resolved with LegiScan
+func LegiScanBody(s congress.State) legislature.Body {
+	return legislature.Body{
+		ID:         legislature.BodyID(strings.ToLower(s.ID) + "-legislature"),
+		Name:       s.Long + " Legislature",
+		DisplayID:  s.ID + "-Legislature",
+		Location:   s.Long,
+		URL:        "https://legiscan.com/" + s.ID,
+		MemberName: "Legislator",
+		Sort:       legislature.GenericLegislationSort,
+	}
+}
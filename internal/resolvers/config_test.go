@@ -0,0 +1,191 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+	"github.com/jehiah/legislation.support/internal/resolvers/nysenate"
+	log "github.com/sirupsen/logrus"
+)
+
+func TestMain(m *testing.M) {
+	if err := Load(""); err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestDefaultConfig(t *testing.T) {
+	for _, env := range []string{"NY_SENATE_TOKEN", "CONGRESS_GOV_APIKEY", "OPENSTATES_API_KEY", "OPENSTATES_DIR", "LEGISCAN_API_KEY", "LEGISTAR_TOKEN"} {
+		t.Setenv(env, "")
+	}
+	c, err := ReadConfig("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, bodies, err := c.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	type testCase struct {
+		body        legislature.BodyID
+		unavailable bool
+	}
+	tests := []testCase{
+		{"nyc", false},
+		{"nysenate", true},
+		{"us-house", true},
+		{"nj-assembly", true},
+		{"chicago", false},
+		{"ca-legislature", true},
+	}
+	for _, tc := range tests {
+		t.Run(string(tc.body), func(t *testing.T) {
+			b, ok := bodies[tc.body]
+			if !ok {
+				t.Fatalf("body %q not configured", tc.body)
+			}
+			if b.Unavailable != tc.unavailable {
+				t.Errorf("Unavailable got %v expected %v", b.Unavailable, tc.unavailable)
+			}
+			if r.Find(tc.body) == nil {
+				t.Errorf("missing resolver")
+			}
+		})
+	}
+	if _, ok := bodies["ny-legislature"]; ok {
+		t.Errorf("unexpected LegiScan body for excluded state NY")
+	}
+
+	// disabled resolvers don't claim URLs
+	u, _ := url.Parse("https://www.nysenate.gov/legislation/bills/2023/S1234")
+	if l, err := r.Lookup(context.Background(), u); l != nil || err != nil {
+		t.Errorf("Lookup got %v %v expected no match", l, err)
+	}
+	if _, err := r.Find("nysenate").Refresh(context.Background(), "2023-S1234"); !errors.Is(err, legislature.ErrUnavailable) {
+		t.Errorf("Refresh got %v expected ErrUnavailable", err)
+	}
+	if got := r.Find("nysenate").DisplayID("2023-S1234"); got != "S1234" {
+		t.Errorf("DisplayID got %q", got)
+	}
+}
+
+func TestConfigBuildErrors(t *testing.T) {
+	tests := map[string]Config{
+		"unknown resolver":   {Bodies: []BodyConfig{{ID: "a", Resolver: "unknown"}}},
+		"missing bicameral":  {Bodies: []BodyConfig{{ID: "nyc", Resolver: "nyc", Bicameral: "b"}}},
+		"duplicate":          {Bodies: []BodyConfig{{ID: "nyc", Resolver: "nyc"}, {ID: "nyc", Resolver: "nyc"}}},
+		"openstates chamber": {Bodies: []BodyConfig{{ID: "nj", Resolver: "openstates", State: "nj"}}},
+		"duplicate state": {
+			Bodies:   []BodyConfig{{ID: "nj-senate", Resolver: "openstates", State: "nj", Chamber: "upper"}},
+			LegiScan: &LegiScanConfig{States: []string{"*"}, Exclude: []string{"NY"}},
+		},
+		"duplicate ny": {
+			Bodies:   []BodyConfig{{ID: "nysenate", Resolver: "nysenate"}},
+			LegiScan: &LegiScanConfig{States: []string{"ny"}},
+		},
+		"invalid session": {Bodies: []BodyConfig{{ID: "a", Resolver: "legistar", Sessions: []SessionConfig{{StartYear: 2024, EndYear: 2023}}}}},
+		"invalid convene": {Bodies: []BodyConfig{{ID: "a", Resolver: "legistar", Calendar: &CalendarConfig{FirstYear: 2020, Lengths: []int{2}, Convene: "Jan 3"}}}},
+		"special session": {Bodies: []BodyConfig{{ID: "a", Resolver: "legistar", Sessions: []SessionConfig{{StartYear: 2024, EndYear: 2025, Special: true}}}}},
+	}
+	for name, c := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := c.Build(); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
+
+func TestConfigCalendar(t *testing.T) {
+	c := Config{Bodies: []BodyConfig{{
+		ID:       "a",
+		Resolver: "legistar",
+		Calendar: &CalendarConfig{FirstYear: 2020, Lengths: []int{4}, Convene: "01-08", LameDuckDays: 10},
+		Sessions: []SessionConfig{{StartYear: 2021, EndYear: 2021, Start: "2021-07-01", End: "2021-07-15", Special: true}},
+	}}}
+	_, bodies, err := c.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cal := bodies["a"].Calendar
+	if cal == nil {
+		t.Fatal("missing calendar")
+	}
+	session := legislature.Session{StartYear: 2020, EndYear: 2023}
+	if got := cal.Find(2022); got != session {
+		t.Errorf("Find got %v expected %v", got, session)
+	}
+	term, _ := cal.Term(session)
+	if want := time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC); !term.End.Equal(want) {
+		t.Errorf("End got %s expected %s", term.End, want)
+	}
+	if !cal.Active(session, time.Date(2024, time.January, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected active during lame duck")
+	}
+	if cal.Active(legislature.Session{StartYear: 2021, EndYear: 2021}, time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected special session to have ended")
+	}
+}
+
+func TestConfigCalendarPerBody(t *testing.T) {
+	c := Config{Bodies: []BodyConfig{
+		{ID: "ny-senate", Resolver: "nysenate", APIKey: "x", Calendar: &CalendarConfig{FirstYear: 2008, Lengths: []int{2}}},
+		{ID: "ny-assembly", Resolver: "ny-assembly", APIKey: "x"},
+	}}
+	resolvers, bodies, err := c.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := bodies["ny-senate"].Calendar.Find(2010); got != (legislature.Session{StartYear: 2010, EndYear: 2011}) {
+		t.Errorf("ny-senate got %v", got)
+	}
+	if got := bodies["ny-assembly"].Calendar.Find(2010); got != (legislature.Session{StartYear: 2009, EndYear: 2010}) {
+		t.Errorf("ny-assembly got %v", got)
+	}
+	if nysenate.DefaultCalendar.FirstYear != 2007 {
+		t.Errorf("DefaultCalendar was changed %#v", nysenate.DefaultCalendar)
+	}
+	id, ok := resolvers.Find("ny-senate").(legislature.CitationParser).ParseCitation("2010-S1", legislature.Session{})
+	if !ok || id != "2010-S1" {
+		t.Errorf("ParseCitation got %q %v", id, ok)
+	}
+}
+
+func TestAvailableBodies(t *testing.T) {
+	var expected int
+	for _, b := range Bodies {
+		if !b.Unavailable {
+			expected++
+		}
+	}
+	got := AvailableBodies()
+	if len(got) != expected || len(got) == 0 {
+		t.Fatalf("got %d bodies expected %d", len(got), expected)
+	}
+	for _, id := range got {
+		if !IsAvailable(id) {
+			t.Errorf("%s is unavailable", id)
+		}
+	}
+}
+
+func TestSearchBodies(t *testing.T) {
+	bodies := SearchBodies()
+	if len(bodies) == 0 {
+		t.Fatal("no search bodies")
+	}
+	for i, b := range bodies {
+		if b.Unavailable {
+			t.Errorf("%s is unavailable", b.ID)
+		}
+		if i > 0 && bodies[i-1].Name > b.Name {
+			t.Errorf("%s is not sorted", b.Name)
+		}
+	}
+}
@@ -45,7 +45,7 @@ type Bill struct {
 	Actions struct {
 		Count json.Number  `json:"count"`
 		URL   string       `json:"url"`
-		Items []BillAction `json:"actions"`
+		Items []BillAction `json:"actions"` // filled by GetActions (most recent first)
 	} `json:"actions"`
 
 	Summaries struct {
@@ -81,19 +81,25 @@ type Bill struct {
 }
 
 type BillSponsor struct {
-	BioguideID      string      `json:"bioguideId"`
-	FullName        string      `json:"fullName"` //  "Rep. Craig, Angie [D-MN-2]",
-	FirstName       string      `json:"firstName"`
-	LastName        string      `json:"lastName"`
-	Party           string      `json:"party"`
-	State           string      `json:"state"`
-	District        json.Number `json:"district"`
-	IsByRequest     string      `json:"isByRequest"`
-	URL             string      `json:"url"`
-	SponsorDate     string      `json:"sponsorDate,omitempty"`
-	OriginalSponsor bool        `json:"originalSponsor,omitempty"`
+	BioguideID  string      `json:"bioguideId"`
+	FullName    string      `json:"fullName"` //  "Rep. Craig, Angie [D-MN-2]",
+	FirstName   string      `json:"firstName"`
+	LastName    string      `json:"lastName"`
+	Party       string      `json:"party"`
+	State       string      `json:"state"`
+	District    json.Number `json:"district"`
+	IsByRequest string      `json:"isByRequest"`
+	URL         string      `json:"url"`
+
+	// cosponsors only
+	SponsorshipDate          string `json:"sponsorshipDate,omitempty"`
+	SponsorshipWithdrawnDate string `json:"sponsorshipWithdrawnDate,omitempty"`
+	IsOriginalCosponsor      bool   `json:"isOriginalCosponsor,omitempty"`
 }
 
+// IsWithdrawn is true for cosponsors who have withdrawn
+func (s BillSponsor) IsWithdrawn() bool { return s.SponsorshipWithdrawnDate != "" }
+
 type BillAction struct {
 	ActionDate   string `json:"actionDate"`
 	ActionTime   string `json:"actionTime"`
@@ -108,6 +114,77 @@ type BillAction struct {
 	RecordedVotes []RecordedVote    `json:"recordedVotes"`
 }
 
+// Chamber returns House or Senate based on the source system (if known)
+func (a BillAction) Chamber() string {
+	switch a.SourceSystem.Code {
+	case 0:
+		if a.SourceSystem.Name == "Senate" {
+			return "Senate"
+		}
+	case 1, 2:
+		return "House"
+	}
+	return ""
+}
+
+// Kind categorizes the action using the congress.gov action type
+// https://github.com/LibraryOfCongress/api.congress.gov/blob/main/Documentation/BillEndpoint.md
+func (a BillAction) Kind() legislature.ActionKind {
+	if strings.HasPrefix(a.Text, "Motion to reconsider") {
+		return legislature.FloorAction
+	}
+	kind := legislature.ActionKindFromText(a.Text)
+	switch a.Type {
+	case "IntroReferral":
+		if kind == legislature.IntroducedAction {
+			return kind
+		}
+		return legislature.ReferredAction
+	case "Committee", "Discharge":
+		if kind == legislature.ReportedAction || kind == legislature.AmendedAction {
+			return kind
+		}
+		return legislature.CommitteeAction
+	case "Floor", "Calendars", "ResolvingDifferences":
+		switch kind {
+		case legislature.PassedAction, legislature.FailedAction, legislature.AmendedAction:
+			return kind
+		}
+		return legislature.FloorAction
+	case "President":
+		if kind == legislature.SignedAction {
+			return kind
+		}
+		return legislature.DeliveredAction
+	case "BecameLaw":
+		return legislature.SignedAction
+	case "Veto":
+		if kind == legislature.OverriddenAction {
+			return kind
+		}
+		return legislature.VetoedAction
+	}
+	return kind
+}
+
+// ToAction converts a BillAction to a legislature.Action
+func (a BillAction) ToAction() legislature.Action {
+	date, _ := parseDate(a.ActionDate)
+	action := legislature.Action{
+		Date:    date,
+		Chamber: a.Chamber(),
+		Text:    a.Text,
+		Kind:    a.Kind(),
+	}
+	for _, c := range a.Committees {
+		if c.Name != "" {
+			action.Committee = c.Name
+			break
+		}
+	}
+	return action
+}
+
 type CommitteeAction struct {
 	Name       string `json:"name"`
 	URL        string `json:"url"`
@@ -170,13 +247,28 @@ func (s BillSponsor) ToLegislatureMember() legislature.Member {
 
 // ToLegislation converts a Congress.gov Bill to a legislature.Legislation
 func (b Bill) ToLegislation(bodyID legislature.BodyID) (*legislature.Legislation, error) {
+	// Parse dates
+	introducedDate, _ := parseDate(b.IntroducedDate)
+	lastModified, _ := parseDate(b.UpdateDate)
+
 	// Convert sponsors
-	var sponsors []legislature.Member
+	var sponsors, withdrawn []legislature.Member
 	for _, s := range b.Sponsors {
-		sponsors = append(sponsors, s.ToLegislatureMember())
+		m := s.ToLegislatureMember()
+		m.Role = legislature.PrimeSponsor
+		m.SponsorDate = introducedDate
+		sponsors = append(sponsors, m)
 	}
 	for _, s := range b.Cosponsors.Items {
-		sponsors = append(sponsors, s.ToLegislatureMember())
+		m := s.ToLegislatureMember()
+		m.Role = legislature.Cosponsor
+		m.SponsorDate, _ = parseDate(s.SponsorshipDate)
+		if s.IsWithdrawn() {
+			m.WithdrawnDate, _ = parseDate(s.SponsorshipWithdrawnDate)
+			withdrawn = append(withdrawn, m)
+			continue
+		}
+		sponsors = append(sponsors, m)
 	}
 
 	session := SessionForCongress(b.Congress)
@@ -197,16 +289,18 @@ func (b Bill) ToLegislation(bodyID legislature.BodyID) (*legislature.Legislation
 		}
 	}
 
+	// actions are returned most recent first
+	var actions []legislature.Action
+	for i := len(b.Actions.Items) - 1; i >= 0; i-- {
+		actions = append(actions, b.Actions.Items[i].ToAction())
+	}
+
 	// Get summary
 	var summary string
 	if len(b.Summaries.Items) > 0 {
 		summary = b.Summaries.Items[0].Text
 	}
 
-	// Parse dates
-	introducedDate, _ := parseDate(b.IntroducedDate)
-	lastModified, _ := parseDate(b.UpdateDate)
-
 	// Determine bill type
 	billType := legislature.BillType
 	if b.Type == "HRES" || b.Type == "SRES" || b.Type == "HCONRES" || b.Type == "SCONRES" || b.Type == "HJRES" || b.Type == "SJRES" {
@@ -217,19 +311,21 @@ func (b Bill) ToLegislation(bodyID legislature.BodyID) (*legislature.Legislation
 	billURL := fmt.Sprintf("https://www.congress.gov/bill/%dth-congress/%s/%s", b.Congress, billTypeToName(b.Type), b.Number)
 
 	leg := &legislature.Legislation{
-		Body:           bodyID,
-		ID:             b.ID(),
-		DisplayID:      formatDisplayID(b.Type, b.Number),
-		Title:          title,
-		Summary:        summary,
-		Description:    "",
-		URL:            billURL,
-		Session:        session,
-		Status:         b.LatestAction.Text,
-		Type:           billType,
-		Sponsors:       sponsors,
-		IntroducedDate: introducedDate,
-		LastModified:   lastModified,
+		Body:              bodyID,
+		ID:                b.ID(),
+		DisplayID:         formatDisplayID(b.Type, b.Number),
+		Title:             title,
+		Summary:           summary,
+		Description:       "",
+		URL:               billURL,
+		Session:           session,
+		Status:            b.LatestAction.Text,
+		Actions:           actions,
+		Type:              billType,
+		Sponsors:          sponsors,
+		WithdrawnSponsors: withdrawn,
+		IntroducedDate:    introducedDate,
+		LastModified:      lastModified,
 	}
 
 	return leg, nil
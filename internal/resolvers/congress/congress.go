@@ -3,6 +3,7 @@ package congress
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -59,15 +60,15 @@ func NewAPI(token string) *CongressAPI {
 	if token == "" {
 		token = os.Getenv("CONGRESS_GOV_APIKEY")
 	}
-	if token == "" {
-		panic("missing api key")
-	}
 	return &CongressAPI{
 		token: token,
 	}
 }
 
 func (a CongressAPI) get(ctx context.Context, path string, params url.Values, v interface{}) error {
+	if a.token == "" {
+		return errors.New("missing congress.gov API key")
+	}
 	if params == nil {
 		params = url.Values{}
 	}
@@ -40,7 +40,7 @@ func (a *CongressAPI) Lookup(ctx context.Context, u *url.URL) (*Bill, error) {
 	if err != nil {
 		return nil, err
 	}
-	return a.GetBill(ctx, congressNum, billTypeNameToCode(billTypeName), number)
+	return a.GetBill(ctx, congressNum, BillTypeNameToCode(billTypeName), number)
 }
 
 // GetBill fetches a bill from the Congress.gov API
@@ -58,6 +58,10 @@ func (a *CongressAPI) GetBill(ctx context.Context, congress int, billType, numbe
 	if err != nil {
 		return nil, err
 	}
+	resp.Bill.Actions.Items, err = a.GetActions(ctx, congress, billType, number)
+	if err != nil {
+		return nil, err
+	}
 	return &resp.Bill, nil
 }
 
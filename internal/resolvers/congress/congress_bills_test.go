@@ -103,6 +103,34 @@ func TestBillTypeToName(t *testing.T) {
 	}
 }
 
+func TestBillActionKind(t *testing.T) {
+	tests := []struct {
+		actionType string
+		text       string
+		want       legislature.ActionKind
+	}{
+		{"IntroReferral", "Introduced in House", legislature.IntroducedAction},
+		{"IntroReferral", "Referred to the House Committee on Energy and Commerce.", legislature.ReferredAction},
+		{"Committee", "Subcommittee Hearings Held", legislature.CommitteeAction},
+		{"Committee", "Reported (Amended) by the Committee on Ways and Means.", legislature.ReportedAction},
+		{"Floor", "Passed/agreed to in House: On passage Passed by recorded vote: 215 - 214.", legislature.PassedAction},
+		{"Floor", "Motion to reconsider laid on the table Agreed to without objection.", legislature.FloorAction},
+		{"Calendars", "Placed on the Union Calendar, Calendar No. 12.", legislature.FloorAction},
+		{"President", "Presented to President.", legislature.DeliveredAction},
+		{"President", "Signed by President.", legislature.SignedAction},
+		{"BecameLaw", "Became Public Law No: 119-21.", legislature.SignedAction},
+		{"Veto", "Vetoed by President.", legislature.VetoedAction},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			a := BillAction{Type: tt.actionType, Text: tt.text}
+			if got := a.Kind(); got != tt.want {
+				t.Errorf("Kind() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCongressNumber(t *testing.T) {
 	tests := []struct {
 		session legislature.Session
@@ -165,7 +193,7 @@ func TestGetBill(t *testing.T) {
 	}
 
 	api := NewAPI("")
-	session := Sessions.Find(2025)
+	session := Calendar.Find(2025)
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -357,3 +385,28 @@ func TestGetVoteXML(t *testing.T) {
 		})
 	}
 }
+
+func TestParseBillNumber(t *testing.T) {
+	type testCase struct {
+		in               string
+		billType, number string
+		ok               bool
+	}
+	tests := []testCase{
+		{"H.R. 1234", "HR", "1234", true},
+		{"hr1234", "HR", "1234", true},
+		{"S. 874", "S", "874", true},
+		{"H.J.Res. 7", "HJRES", "7", true},
+		{"s res 012", "SRES", "12", true},
+		{"clean energy", "", "", false},
+		{"XY 12", "", "", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			billType, number, ok := parseBillNumber(tc.in)
+			if billType != tc.billType || number != tc.number || ok != tc.ok {
+				t.Errorf("got %q %q %v expected %q %q %v", billType, number, ok, tc.billType, tc.number, tc.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package congress
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+// parseCitation parses a bill citation for a chamber ("H" or "S") in the forms
+// "H.R. 5", "S. 874", "house-bill 5" or a LegislationID "118-HR5"
+func parseCitation(chamberPrefix, citation string, session legislature.Session) (legislature.LegislationID, bool) {
+	citation = strings.TrimSpace(citation)
+	congress := congressNumber(session)
+	var billType, number string
+	if c, t, n, err := parseBillID(legislature.LegislationID(strings.ToUpper(citation))); err == nil {
+		if SessionForCongress(c).StartYear == 0 {
+			return "", false
+		}
+		congress, billType, number = c, t, n
+	} else if name, n, ok := strings.Cut(citation, " "); ok && BillTypeNameToCode(strings.ToLower(name)) != "" {
+		billType, number = strings.ToUpper(BillTypeNameToCode(strings.ToLower(name))), strings.TrimSpace(n)
+	} else {
+		billType, number, _ = parseBillNumber(citation)
+	}
+	if n, err := strconv.Atoi(number); err != nil || n <= 0 || !strings.HasPrefix(billType, chamberPrefix) {
+		return "", false
+	}
+	return legislature.LegislationID(fmt.Sprintf("%d-%s%s", congress, billType, number)), true
+}
+
+// ParseCitation parses a House bill citation (i.e. "H.R. 5" or "H.Res. 12")
+func (h House) ParseCitation(citation string, session legislature.Session) (legislature.LegislationID, bool) {
+	return parseCitation("H", citation, session)
+}
+
+// ParseCitation parses a Senate bill citation (i.e. "S. 874")
+func (s Senate) ParseCitation(citation string, session legislature.Session) (legislature.LegislationID, bool) {
+	return parseCitation("S", citation, session)
+}
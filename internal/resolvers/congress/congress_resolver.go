@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 
 	"github.com/jehiah/legislation.support/internal/legislature"
@@ -23,7 +24,7 @@ func (s Senate) SupportedDomains() []string {
 // Lookup finds a House bill from a URL
 func (h House) Lookup(ctx context.Context, u *url.URL) (*legislature.Legislation, error) {
 	bill, err := h.api.Lookup(ctx, u)
-	if err != nil {
+	if err != nil || bill == nil {
 		return nil, err
 	}
 	if bill.OriginChamber != "House" {
@@ -35,7 +36,7 @@ func (h House) Lookup(ctx context.Context, u *url.URL) (*legislature.Legislation
 // Lookup finds a Senate bill from a URL
 func (s Senate) Lookup(ctx context.Context, u *url.URL) (*legislature.Legislation, error) {
 	bill, err := s.api.Lookup(ctx, u)
-	if err != nil {
+	if err != nil || bill == nil {
 		return nil, err
 	}
 	if bill.OriginChamber != "Senate" {
@@ -44,8 +45,8 @@ func (s Senate) Lookup(ctx context.Context, u *url.URL) (*legislature.Legislatio
 	return bill.ToLegislation(s.body.ID)
 }
 
-// billTypeNameToCode converts URL bill type name to API code
-func billTypeNameToCode(name string) string {
+// BillTypeNameToCode converts a congress.gov URL bill type name (i.e. "house-bill") to an API code
+func BillTypeNameToCode(name string) string {
 	switch name {
 	case "house-bill":
 		return "hr"
@@ -113,13 +114,25 @@ func Link(l legislature.LegislationID) *url.URL {
 	if err != nil {
 		log.Errorf("congress.Link: unable to parse bill ID %q: %v", l, err)
 	}
+	return BillURL(congress, billType, number)
+}
+
+// BillURL returns the congress.gov URL for a bill. billType is an API code (i.e. "hr" or "HR")
+//
+// Example: BillURL(118, "hr", "1234") => https://www.congress.gov/bill/118th-congress/house-bill/1234
+func BillURL(congress int, billType, number string) *url.URL {
 	return &url.URL{
 		Scheme: "https",
 		Host:   "www.congress.gov",
-		Path:   fmt.Sprintf("/bill/%dth-congress/%s/%s", congress, billTypeToName(billType), number),
+		Path:   fmt.Sprintf("/bill/%dth-congress/%s/%s", congress, billTypeToName(strings.ToUpper(billType)), number),
 	}
 }
 
+// IsBillType is true if billType is an API code (i.e. "hr", "sjres")
+func IsBillType(billType string) bool {
+	return slices.Contains(BillTypes, strings.ToUpper(billType))
+}
+
 // Link generates a URL for a House bill
 func (h House) Link(l legislature.LegislationID) *url.URL {
 	return Link(l)
@@ -157,3 +170,13 @@ func (h House) Scorecard(ctx context.Context, items []legislature.Scorable) (*le
 func (s Senate) Scorecard(ctx context.Context, items []legislature.Scorable) (*legislature.Scorecard, error) {
 	return scorecardForCongress(ctx, s.body, s.api, ChamberSenate, items)
 }
+
+// Votes returns the recorded votes for a House bill
+func (h House) Votes(ctx context.Context, id legislature.LegislationID) ([]legislature.RollCall, error) {
+	return h.api.Votes(ctx, id)
+}
+
+// Votes returns the recorded votes for a Senate bill
+func (s Senate) Votes(ctx context.Context, id legislature.LegislationID) ([]legislature.RollCall, error) {
+	return s.api.Votes(ctx, id)
+}
@@ -8,11 +8,24 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
-// skip votes like "Motion to reconsider laid on the table"
+// IsVoteIrelevant is true for procedural motions where a vote doesn't reflect
+// support for the bill. i.e. "Motion to reconsider laid on the table" or
+// "On motion to recommit" (where Aye is a vote against the bill)
 func (a BillAction) IsVoteIrelevant() bool {
-	switch {
-	case strings.HasPrefix(a.Text, "Motion to reconsider laid on the table"):
-		return true
+	text := strings.TrimPrefix(strings.ToLower(a.Text), "on ")
+	for _, prefix := range []string{
+		"motion to reconsider laid on the table",
+		"motion to table",
+		"motion to recommit",
+		"ordering the previous question",
+		"motion to adjourn",
+		"motion to instruct conferees",
+		"point of order",
+		"quorum call",
+	} {
+		if strings.HasPrefix(text, prefix) {
+			return true
+		}
 	}
 	return false
 }
@@ -40,6 +53,7 @@ func scorecardForCongress(ctx context.Context, body legislature.Body, api *Congr
 		return nil, err
 	}
 	s.People = people
+	crosswalk := NewLISCrosswalk(members)
 
 	g := new(errgroup.Group)
 	g.SetLimit(5)
@@ -57,49 +71,38 @@ func scorecardForCongress(ctx context.Context, body legislature.Body, api *Congr
 				return err
 			}
 
-			// actions, err = api.GetActions(ctx, congress, billType, number)
-			// if err != nil {
-			// 	return nil, err
-			// }
-
 			sb.Status = bill.LatestAction.Text
-			// sb.Committee = committeeFromActions(actions)
+			sb.Committee = committeeFromActions(bill.Actions.Items)
 
 			scores := make(map[string]string)
 			for _, sponsor := range bill.Sponsors {
 				if sponsor.BioguideID == "" {
 					continue
 				}
-				scores[sponsor.BioguideID] = "Sponsor"
+				scores[sponsor.BioguideID] = string(legislature.PrimeSponsor)
 			}
 			for _, cosponsor := range bill.Cosponsors.Items {
-				if cosponsor.BioguideID == "" {
+				if cosponsor.BioguideID == "" || cosponsor.IsWithdrawn() {
 					continue
 				}
 				scores[cosponsor.BioguideID] = "Sponsor"
 			}
 
-			// for _, action := range actions {
-			// 	if action.IsVoteIrelevant() {
-			// 		continue
-			// 	}
-			// 	for _, rv := range action.RecordedVotes {
-			// 		if !voteMatchesChamber(rv.Chamber, chamber) {
-			// 			continue
-			// 		}
-			// 		vote, err := api.GetVoteXML(ctx, rv.URL)
-			// 		if err != nil {
-			// 			return err
-			// 		}
-			// 		for _, member := range vote.Members {
-			// 			memberID := member.LISMemberID
-			// 			if memberID == "" {
-			// 				continue
-			// 			}
-			// 			scores[memberID] = normalizeVoteCast(member.VoteCast)
-			// 		}
-			// 	}
-			// }
+			rollCalls, err := api.rollCalls(ctx, bill.Actions.Items, crosswalk)
+			if err != nil {
+				return err
+			}
+			for _, r := range rollCalls {
+				if !voteMatchesChamber(r.Chamber, chamber) {
+					continue
+				}
+				for _, v := range r.Votes {
+					if v.Member.Slug == "" {
+						continue
+					}
+					scores[v.Member.Slug] = string(v.Position)
+				}
+			}
 
 			for idx := range s.People {
 				status := scores[peopleIDs[idx]]
@@ -161,18 +164,3 @@ func voteMatchesChamber(voteChamber, chamber string) bool {
 	}
 	return voteChamber == chamber
 }
-
-func normalizeVoteCast(vote string) string {
-	switch strings.ToLower(strings.TrimSpace(vote)) {
-	case "yea", "yes", "aye":
-		return "Aye"
-	case "nay", "no":
-		return "Nay"
-	case "present":
-		return "Present"
-	case "not voting", "absent", "not-voting":
-		return "Not Voting"
-	default:
-		return strings.TrimSpace(vote)
-	}
-}
@@ -0,0 +1,55 @@
+package congress
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+// i.e. "hr1234" after removing spaces and periods from "H.R. 1234"
+var billNumberPattern = regexp.MustCompile(`^([a-z]+)([0-9]+)$`)
+
+// parseBillNumber parses a bill number like "H.R. 1234" or "S 874" into an API bill type and number
+func parseBillNumber(q string) (billType, number string, ok bool) {
+	q = strings.ToLower(strings.NewReplacer(".", "", " ", "", "\t", "").Replace(q))
+	p := billNumberPattern.FindStringSubmatch(q)
+	if p == nil || !IsBillType(p[1]) {
+		return "", "", false
+	}
+	return strings.ToUpper(p[1]), strings.TrimLeft(p[2], "0"), true
+}
+
+// Search finds a House bill by number (i.e. "H.R. 1234").
+//
+// The congress.gov API does not support a keyword search so other queries have no results
+func (h House) Search(ctx context.Context, query string, session legislature.Session) ([]legislature.Legislation, error) {
+	return search(ctx, h.api, h.body.ID, "H", query, session)
+}
+
+// Search finds a Senate bill by number (i.e. "S. 874").
+//
+// The congress.gov API does not support a keyword search so other queries have no results
+func (s Senate) Search(ctx context.Context, query string, session legislature.Session) ([]legislature.Legislation, error) {
+	return search(ctx, s.api, s.body.ID, "S", query, session)
+}
+
+func search(ctx context.Context, api *CongressAPI, body legislature.BodyID, chamberPrefix, query string, session legislature.Session) ([]legislature.Legislation, error) {
+	billType, number, ok := parseBillNumber(query)
+	if !ok || !strings.HasPrefix(billType, chamberPrefix) {
+		return nil, nil
+	}
+	bill, err := api.GetBill(ctx, congressNumber(session), billType, number)
+	if err != nil {
+		return nil, err
+	}
+	if bill == nil || bill.Number == "" {
+		return nil, nil
+	}
+	l, err := bill.ToLegislation(body)
+	if err != nil {
+		return nil, err
+	}
+	return []legislature.Legislation{*l}, nil
+}
@@ -6,16 +6,14 @@ import (
 	"github.com/jehiah/legislation.support/internal/legislature"
 )
 
-// Sessions defines the congressional sessions (each congress is 2 years)
-var Sessions = legislature.Sessions{
-	{StartYear: 2025, EndYear: 2026}, // 119th
-	{StartYear: 2023, EndYear: 2024}, // 118th
-	{StartYear: 2021, EndYear: 2022}, // 117th
-	{StartYear: 2019, EndYear: 2020}, // 116th
-	{StartYear: 2017, EndYear: 2018}, // 115th
-	{StartYear: 2015, EndYear: 2016}, // 114th
-	{StartYear: 2013, EndYear: 2014}, // 113th
-	{StartYear: 2011, EndYear: 2012}, // 112th
+// Calendar is the congressional calendar. Since the 74th congress (1935) each
+// congress is 2 years and convenes January 3rd; the lame-duck session after
+// the November election is before the next congress convenes.
+var Calendar = legislature.Calendar{
+	FirstYear:    1935,
+	Lengths:      []int{2},
+	ConveneMonth: time.January,
+	ConveneDay:   3,
 }
 
 func congressNumber(s legislature.Session) int {
@@ -26,5 +24,5 @@ func congressNumber(s legislature.Session) int {
 }
 
 func SessionForCongress(congress int) legislature.Session {
-	return Sessions.Find(congress*2 + 1787)
+	return Calendar.Find(congress*2 + 1787)
 }
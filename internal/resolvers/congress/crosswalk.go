@@ -0,0 +1,88 @@
+package congress
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// LISCrosswalk maps the LIS member IDs used in Senate roll call votes to bioguide IDs.
+//
+// The congress.gov API doesn't expose LIS member IDs so Senators are matched
+// by last name and state (or first and last name and state when that is ambiguous)
+type LISCrosswalk struct {
+	byKey map[string]string
+}
+
+// NewLISCrosswalk indexes the members who served in the Senate
+func NewLISCrosswalk(members []Member) LISCrosswalk {
+	c := LISCrosswalk{byKey: make(map[string]string)}
+	ambiguous := make(map[string]bool)
+	add := func(key, id string) {
+		if existing, ok := c.byKey[key]; ok && existing != id {
+			ambiguous[key] = true
+		}
+		c.byKey[key] = id
+	}
+	for _, m := range members {
+		if m.BioguideID == "" || !m.ServedInSenate() {
+			continue
+		}
+		state := normalizeShortState(m.State)
+		last, first, _ := strings.Cut(m.Name, ",")
+		add(crosswalkKey(state, last), m.BioguideID)
+		add(crosswalkKey(state, firstWord(first), last), m.BioguideID)
+	}
+	for key := range ambiguous {
+		delete(c.byKey, key)
+	}
+	return c
+}
+
+// BioguideID returns the bioguide ID for a member in a Senate roll call vote (or "" when unknown)
+func (c LISCrosswalk) BioguideID(m VoteXMLMember) string {
+	if id, ok := c.byKey[crosswalkKey(m.State, m.LastName)]; ok {
+		return id
+	}
+	return c.byKey[crosswalkKey(m.State, firstWord(m.FirstName), m.LastName)]
+}
+
+// ServedInSenate is true if any of the members terms were in the Senate
+func (m Member) ServedInSenate() bool {
+	for _, t := range m.Term.Items {
+		if strings.HasPrefix(t.Chamber, ChamberSenate) {
+			return true
+		}
+	}
+	return false
+}
+
+func crosswalkKey(state string, name ...string) string {
+	key := strings.ToUpper(strings.TrimSpace(state))
+	for _, n := range name {
+		key += "|" + foldName(n)
+	}
+	return key
+}
+
+// foldName lowercases and removes accents and punctuation. Luján => lujan
+func foldName(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+		case unicode.IsLetter(r), unicode.IsDigit(r):
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+func firstWord(s string) string {
+	f := strings.Fields(s)
+	if len(f) == 0 {
+		return ""
+	}
+	return f[0]
+}
@@ -0,0 +1,61 @@
+package congress
+
+import (
+	"testing"
+)
+
+func TestLISCrosswalk(t *testing.T) {
+	senate := []Term{{StartYear: 2013, Chamber: "Senate"}}
+	house := []Term{{StartYear: 2013, Chamber: "House of Representatives"}}
+	member := func(id, name, state string, terms []Term) Member {
+		m := Member{BioguideID: id, Name: name, State: state}
+		m.Term.Items = terms
+		return m
+	}
+	c := NewLISCrosswalk([]Member{
+		member("S000148", "Schumer, Charles E.", "New York", senate),
+		member("G000555", "Gillibrand, Kirsten E.", "New York", senate),
+		member("L000570", "Luján, Ben Ray", "New Mexico", senate),
+		member("U000039", "Udall, Tom", "New Mexico", senate),
+		member("U000038", "Udall, Mark", "New Mexico", senate),
+		member("S000001", "Schumer, Someone", "New York", house),
+	})
+
+	tests := []struct {
+		m    VoteXMLMember
+		want string
+	}{
+		{VoteXMLMember{LastName: "Schumer", FirstName: "Charles", State: "NY", LISMemberID: "S270"}, "S000148"},
+		{VoteXMLMember{LastName: "Gillibrand", FirstName: "Kirsten", State: "NY"}, "G000555"},
+		{VoteXMLMember{LastName: "Lujan", FirstName: "Ben", State: "NM"}, "L000570"},
+		{VoteXMLMember{LastName: "Udall", FirstName: "Tom", State: "NM"}, "U000039"},
+		{VoteXMLMember{LastName: "Udall", FirstName: "Mark", State: "NM"}, "U000038"},
+		{VoteXMLMember{LastName: "Schumer", FirstName: "Charles", State: "NJ"}, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.m.LastName+"-"+tc.m.State, func(t *testing.T) {
+			if got := c.BioguideID(tc.m); got != tc.want {
+				t.Errorf("BioguideID(%#v) = %q, want %q", tc.m, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsVoteIrelevant(t *testing.T) {
+	tests := []struct {
+		text string
+		want bool
+	}{
+		{"Motion to reconsider laid on the table Agreed to without objection.", true},
+		{"On motion to recommit Failed by the Yeas and Nays: 201 - 218 (Roll no. 112).", true},
+		{"On ordering the previous question Agreed to by the Yeas and Nays: 218 - 205 (Roll no. 110).", true},
+		{"On passage Passed by the Yeas and Nays: 220 - 208 (Roll no. 113).", false},
+		{"On motion to suspend the rules and pass the bill Agreed to by the Yeas and Nays: (2/3 required): 400 - 20 (Roll no. 45).", false},
+		{"Passed Senate without amendment by Yea-Nay Vote. 68 - 30. Record Vote Number: 200.", false},
+	}
+	for _, tc := range tests {
+		if got := (BillAction{Text: tc.text}).IsVoteIrelevant(); got != tc.want {
+			t.Errorf("IsVoteIrelevant(%q) = %v, want %v", tc.text, got, tc.want)
+		}
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
 )
 
 // RollCallVote represents a unified structure for both Senate and House votes
@@ -40,3 +43,101 @@ func (a *CongressAPI) GetVoteXML(ctx context.Context, url string) (*RollCallVote
 
 	return nil, fmt.Errorf("unsupported vote URL domain: %s", url)
 }
+
+// Votes returns the recorded votes in either chamber for a bill (oldest first)
+func (a *CongressAPI) Votes(ctx context.Context, id legislature.LegislationID) ([]legislature.RollCall, error) {
+	bill, err := a.GetBillByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	var crosswalk LISCrosswalk
+	if hasRecordedVotes(bill.Actions.Items, ChamberSenate) {
+		members, err := a.Members(ctx, SessionForCongress(bill.Congress))
+		if err != nil {
+			return nil, err
+		}
+		crosswalk = NewLISCrosswalk(members)
+	}
+	return a.rollCalls(ctx, bill.Actions.Items, crosswalk)
+}
+
+func hasRecordedVotes(actions []BillAction, chamber string) bool {
+	for _, action := range actions {
+		for _, rv := range action.RecordedVotes {
+			if rv.Chamber == chamber {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rollCalls fetches the recorded votes for actions skipping procedural motions
+func (a *CongressAPI) rollCalls(ctx context.Context, actions []BillAction, crosswalk LISCrosswalk) ([]legislature.RollCall, error) {
+	var out []legislature.RollCall
+	seen := make(map[string]bool)
+	for _, action := range actions {
+		if action.IsVoteIrelevant() {
+			continue
+		}
+		for _, rv := range action.RecordedVotes {
+			// the same vote is often listed on multiple actions
+			if rv.URL == "" || seen[rv.URL] {
+				continue
+			}
+			seen[rv.URL] = true
+			vote, err := a.GetVoteXML(ctx, rv.URL)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, vote.RollCall(rv, action, crosswalk))
+		}
+	}
+	legislature.SortRollCalls(out)
+	return out, nil
+}
+
+// RollCall converts to a legislature.RollCall with members identified by bioguide ID
+// (Member.Slug). House votes use bioguide IDs; Senate votes use LIS member IDs
+func (v RollCallVote) RollCall(rv RecordedVote, action BillAction, crosswalk LISCrosswalk) legislature.RollCall {
+	r := legislature.RollCall{
+		Chamber: rv.Chamber,
+		Motion:  v.Question,
+		Result:  v.VoteResult,
+		Tally: legislature.VoteTally{
+			Aye:   v.Count.Yeas,
+			Nay:   v.Count.Nays,
+			Other: v.Count.Present + v.Count.Absent,
+		},
+	}
+	if t, err := time.Parse(time.RFC3339, rv.Date); err == nil {
+		r.Date = t
+	} else {
+		r.Date, _ = parseDate(action.ActionDate)
+	}
+	if r.Motion == "" {
+		r.Motion = v.VoteQuestionText
+	}
+	if r.Result == "" {
+		r.Result = v.VoteResultText
+	}
+	for _, m := range v.Members {
+		member := legislature.Member{
+			FullName:  m.MemberFull,
+			ShortName: m.LastName,
+			Party:     normalizeParty(m.Party),
+			District:  m.State,
+		}
+		switch rv.Chamber {
+		case ChamberHouse:
+			member.Slug = m.LISMemberID
+		case ChamberSenate:
+			member.Slug = crosswalk.BioguideID(m)
+		}
+		r.Votes = append(r.Votes, legislature.MemberVote{
+			Member:   member,
+			Position: legislature.NormalizeVotePosition(m.VoteCast),
+		})
+	}
+	return r
+}
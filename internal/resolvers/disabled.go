@@ -0,0 +1,44 @@
+package resolvers
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+// disabled wraps a resolver that can't reach its upstream API. Links and display IDs
+// for existing bookmarks still work, but lookups and refreshes return ErrUnavailable
+type disabled struct {
+	legislature.Resolver
+	body legislature.Body
+}
+
+func (d disabled) Body() legislature.Body { return d.body }
+
+// SupportedDomains is empty so that URLs are not matched to a disabled body
+func (d disabled) SupportedDomains() []string { return nil }
+
+func (d disabled) Lookup(ctx context.Context, u *url.URL) (*legislature.Legislation, error) {
+	return nil, nil
+}
+func (d disabled) Refresh(context.Context, legislature.LegislationID) (*legislature.Legislation, error) {
+	return nil, legislature.ErrUnavailable
+}
+func (d disabled) Scorecard(context.Context, []legislature.Scorable) (*legislature.Scorecard, error) {
+	return nil, legislature.ErrUnavailable
+}
+func (d disabled) Members(context.Context, legislature.Session) ([]legislature.Member, error) {
+	return nil, legislature.ErrUnavailable
+}
+func (d disabled) Votes(context.Context, legislature.LegislationID) ([]legislature.RollCall, error) {
+	return nil, legislature.ErrUnavailable
+}
+
+// ParseCitation is passed through so that citations for a disabled body are recognized (and reported as unavailable)
+func (d disabled) ParseCitation(citation string, session legislature.Session) (legislature.LegislationID, bool) {
+	if p, ok := d.Resolver.(legislature.CitationParser); ok {
+		return p.ParseCitation(citation, session)
+	}
+	return "", false
+}
@@ -0,0 +1,233 @@
+// Package legiscan is a resolver for state legislatures using the LegiScan API
+package legiscan
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gosimple/slug"
+	"github.com/jehiah/legislation.support/internal/legislature"
+	log "github.com/sirupsen/logrus"
+)
+
+// LegiScan resolves legislation for one state legislature
+type LegiScan struct {
+	body  legislature.Body
+	state string // i.e. "NJ"
+	api   *API
+}
+
+func New(b legislature.Body, state, key string) *LegiScan {
+	return &LegiScan{
+		body:  b,
+		state: strings.ToUpper(state),
+		api:   NewAPI(key),
+	}
+}
+
+func (l LegiScan) Body() legislature.Body { return l.body }
+
+func (l LegiScan) SupportedDomains() []string {
+	return []string{"legiscan.com"}
+}
+
+// i.e. /NJ/bill/S1234/2024
+var billPattern = regexp.MustCompile(`(?i)^/([A-Z]{2})/bill/([A-Z]+[0-9]+)/((19|20)[0-9]{2})$`)
+
+// LegislationID is the LegiScan bill_id and the bill number i.e. "1234567_S1234"
+//
+// The bill_id is needed to call the API and the bill number is needed for DisplayID
+func LegislationID(b Bill) legislature.LegislationID {
+	return legislature.LegislationID(fmt.Sprintf("%d_%s", b.BillID, b.BillNumber))
+}
+
+// BillID returns the LegiScan bill_id from a LegislationID
+func BillID(id legislature.LegislationID) (int, error) {
+	billID, _, _ := strings.Cut(string(id), "_")
+	n, err := strconv.Atoi(billID)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid LegiScan LegislationID %q", id)
+	}
+	return n, nil
+}
+
+// DisplayID returns the bill number: 1234567_S1234 => S1234
+func (l LegiScan) DisplayID(id legislature.LegislationID) string {
+	_, billNumber, _ := strings.Cut(string(id), "_")
+	return billNumber
+}
+
+func (l LegiScan) Link(id legislature.LegislationID) *url.URL {
+	billID, _ := BillID(id)
+	return &url.URL{
+		Scheme: "https",
+		Host:   "legiscan.com",
+		Path:   fmt.Sprintf("/gaits/view/%d", billID),
+	}
+}
+
+// Lookup matches legiscan.com bill pages for the state
+//
+// Example: https://legiscan.com/NJ/bill/S1234/2024
+func (l LegiScan) Lookup(ctx context.Context, u *url.URL) (*legislature.Legislation, error) {
+	switch u.Hostname() {
+	case "legiscan.com", "www.legiscan.com":
+	default:
+		return nil, nil
+	}
+	p := billPattern.FindStringSubmatch(u.Path)
+	if p == nil || !strings.EqualFold(p[1], l.state) {
+		return nil, nil
+	}
+	billNumber := strings.ToUpper(p[2])
+	year, _ := strconv.Atoi(p[3])
+	results, err := l.api.Search(ctx, l.state, billNumber, year)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range results {
+		if strings.EqualFold(r.BillNumber, billNumber) {
+			log.Infof("found legiscan bill %d for %s", r.BillID, u.String())
+			bill, err := l.api.GetBill(ctx, r.BillID)
+			if err != nil {
+				return nil, err
+			}
+			return l.NewLegislation(*bill), nil
+		}
+	}
+	return nil, nil
+}
+
+func (l LegiScan) Refresh(ctx context.Context, id legislature.LegislationID) (*legislature.Legislation, error) {
+	billID, err := BillID(id)
+	if err != nil {
+		return nil, err
+	}
+	bill, err := l.api.GetBill(ctx, billID)
+	if err != nil {
+		return nil, err
+	}
+	return l.NewLegislation(*bill), nil
+}
+
+// statusNames are the LegiScan progress codes
+var statusNames = map[int]string{
+	1:  "Introduced",
+	2:  "Engrossed",
+	3:  "Enrolled",
+	4:  "Passed",
+	5:  "Vetoed",
+	6:  "Failed",
+	7:  "Veto Override",
+	8:  "Chaptered",
+	9:  "Referred",
+	10: "Reported Favorably",
+	11: "Reported Unfavorably",
+	12: "Draft",
+}
+
+// StatusName describes the bill progress (including the pending committee for introduced bills)
+func (b Bill) StatusName() string {
+	s := statusNames[b.Status]
+	if (b.Status == 1 || b.Status == 9) && b.Committee.Name != "" {
+		return "In Committee: " + b.Committee.Name
+	}
+	return s
+}
+
+// chamberName maps the LegiScan chamber code
+func chamberName(c string) string {
+	switch c {
+	case "S":
+		return "Senate"
+	case "H":
+		return "House"
+	case "A":
+		return "Assembly"
+	}
+	return c
+}
+
+func (l LegiScan) memberURL(peopleID int, name string) string {
+	return fmt.Sprintf("https://legiscan.com/%s/people/%s/id/%d", l.state, slug.MakeLang(name, "en"), peopleID)
+}
+
+func (l LegiScan) NewLegislation(b Bill) *legislature.Legislation {
+	legType := legislature.BillType
+	if strings.Contains(b.BillType, "R") {
+		// R, CR, JR, ...
+		legType = legislature.ResolutionType
+	}
+
+	sponsors := make([]Sponsor, len(b.Sponsors))
+	copy(sponsors, b.Sponsors)
+	sort.SliceStable(sponsors, func(i, j int) bool { return sponsors[i].SponsorOrder < sponsors[j].SponsorOrder })
+	var members []legislature.Member
+	for _, s := range sponsors {
+		if s.PeopleID == 0 || s.CommitteeSponsor != 0 {
+			continue
+		}
+		role := legislature.Cosponsor
+		if s.SponsorTypeID == PrimarySponsor {
+			role = legislature.PrimeSponsor
+		}
+		members = append(members, legislature.Member{
+			NumericID: s.PeopleID,
+			FullName:  strings.TrimSpace(s.Name),
+			ShortName: s.LastName,
+			Slug:      slug.MakeLang(s.Name, "en"),
+			URL:       l.memberURL(s.PeopleID, s.Name),
+			District:  s.District,
+			Party:     s.Party,
+			Role:      role,
+		})
+	}
+
+	var actions []legislature.Action
+	for _, h := range b.History {
+		actions = append(actions, legislature.Action{
+			Date: h.Date.Time,
+			Text: strings.TrimSpace(chamberName(h.Chamber) + " " + h.Action),
+			Kind: legislature.ActionKindFromText(h.Action),
+		})
+	}
+
+	leg := &legislature.Legislation{
+		Body:        l.body.ID,
+		ID:          LegislationID(b),
+		DisplayID:   b.BillNumber,
+		Title:       b.Title,
+		Description: b.Description,
+		URL:         firstNonEmpty(b.StateLink, b.URL),
+		Session:     legislature.Session{StartYear: b.Session.YearStart, EndYear: b.Session.YearEnd},
+		Status:      b.StatusName(),
+		Actions:     actions,
+		Type:        legType,
+		Sponsors:    members,
+	}
+	if b.Description == b.Title {
+		leg.Description = ""
+	}
+	if len(b.History) > 0 {
+		leg.IntroducedDate = b.History[0].Date.Time
+		leg.LastModified = b.History[len(b.History)-1].Date.Time
+	}
+	if b.StatusDate.After(leg.LastModified) {
+		leg.LastModified = b.StatusDate.Time
+	}
+	return leg
+}
+
+func firstNonEmpty(s ...string) string {
+	for _, ss := range s {
+		if ss = strings.TrimSpace(ss); ss != "" {
+			return ss
+		}
+	}
+	return ""
+}
@@ -0,0 +1,309 @@
+package legiscan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// https://legiscan.com/legiscan
+// https://api.legiscan.com/dl/LegiScan_API_User_Manual.pdf
+//
+// ENV LEGISCAN_API_KEY
+
+var ErrMissingKey = errors.New("missing LegiScan API key")
+
+type API struct {
+	key string
+}
+
+func NewAPI(key string) *API {
+	return &API{key: key}
+}
+
+func (a API) get(ctx context.Context, op string, params url.Values, v interface{}) error {
+	if a.key == "" {
+		return ErrMissingKey
+	}
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("op", op)
+	log.WithContext(ctx).WithField("legiscan_api", "https://api.legiscan.com/?"+params.Encode()).Debug("LegiScan.get")
+	params.Set("key", a.key)
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://api.legiscan.com/?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", "https://legislation.support/")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("legiscan api error: %s: %s", resp.Status, string(body))
+	}
+	return decode(op, body, v)
+}
+
+// decode checks the response status before unmarshaling
+func decode(op string, body []byte, v interface{}) error {
+	var status struct {
+		Status string `json:"status"`
+		Alert  struct {
+			Message string `json:"message"`
+		} `json:"alert"`
+	}
+	if err := json.Unmarshal(body, &status); err != nil {
+		return err
+	}
+	if status.Status != "OK" {
+		return fmt.Errorf("legiscan %s error: %s %s", op, status.Status, status.Alert.Message)
+	}
+	return json.Unmarshal(body, v)
+}
+
+// GetBill https://api.legiscan.com/?op=getBill&id=BILL_ID
+func (a API) GetBill(ctx context.Context, billID int) (*Bill, error) {
+	var resp struct {
+		Bill Bill `json:"bill"`
+	}
+	err := a.get(ctx, "getBill", url.Values{"id": []string{strconv.Itoa(billID)}}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Bill, nil
+}
+
+// GetRollCall https://api.legiscan.com/?op=getRollCall&id=ROLL_CALL_ID
+func (a API) GetRollCall(ctx context.Context, rollCallID int) (*RollCall, error) {
+	var resp struct {
+		RollCall RollCall `json:"roll_call"`
+	}
+	err := a.get(ctx, "getRollCall", url.Values{"id": []string{strconv.Itoa(rollCallID)}}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.RollCall, nil
+}
+
+// GetSessionList https://api.legiscan.com/?op=getSessionList&state=STATE
+func (a API) GetSessionList(ctx context.Context, state string) ([]Session, error) {
+	var resp struct {
+		Sessions []Session `json:"sessions"`
+	}
+	err := a.get(ctx, "getSessionList", url.Values{"state": []string{state}}, &resp)
+	return resp.Sessions, err
+}
+
+// GetSessionPeople https://api.legiscan.com/?op=getSessionPeople&id=SESSION_ID
+func (a API) GetSessionPeople(ctx context.Context, sessionID int) ([]Person, error) {
+	var resp struct {
+		SessionPeople struct {
+			People []Person `json:"people"`
+		} `json:"sessionpeople"`
+	}
+	err := a.get(ctx, "getSessionPeople", url.Values{"id": []string{strconv.Itoa(sessionID)}}, &resp)
+	return resp.SessionPeople.People, err
+}
+
+// Search finds a bill by number https://api.legiscan.com/?op=getSearch&state=STATE&bill=BILL_NUMBER&year=YEAR
+func (a API) Search(ctx context.Context, state, billNumber string, year int) ([]SearchResult, error) {
+	return a.search(ctx, url.Values{
+		"state": []string{state},
+		"bill":  []string{billNumber},
+		"year":  []string{strconv.Itoa(year)},
+	})
+}
+
+// SearchText is a full text search. year is an exact year or 2 for the current session
+func (a API) SearchText(ctx context.Context, state, query string, year int) ([]SearchResult, error) {
+	results, err := a.search(ctx, url.Values{
+		"state": []string{state},
+		"query": []string{query},
+		"year":  []string{strconv.Itoa(year)},
+	})
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Relevance > results[j].Relevance })
+	return results, err
+}
+
+func (a API) search(ctx context.Context, params url.Values) ([]SearchResult, error) {
+	var resp struct {
+		SearchResult map[string]json.RawMessage `json:"searchresult"`
+	}
+	err := a.get(ctx, "getSearch", params, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return searchResults(resp.SearchResult)
+}
+
+func searchResults(raw map[string]json.RawMessage) ([]SearchResult, error) {
+	var results []SearchResult
+	for k, v := range raw {
+		// results are keyed "0", "1", ... alongside a "summary"
+		if _, err := strconv.Atoi(k); err != nil {
+			continue
+		}
+		var r SearchResult
+		if err := json.Unmarshal(v, &r); err != nil {
+			return nil, err
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+type Session struct {
+	SessionID    int    `json:"session_id"`
+	StateID      int    `json:"state_id"`
+	YearStart    int    `json:"year_start"`
+	YearEnd      int    `json:"year_end"`
+	Special      int    `json:"special"`
+	SessionName  string `json:"session_name"`
+	SessionTitle string `json:"session_title"`
+}
+
+type Bill struct {
+	BillID      int       `json:"bill_id"`
+	ChangeHash  string    `json:"change_hash"`
+	SessionID   int       `json:"session_id"`
+	Session     Session   `json:"session"`
+	URL         string    `json:"url"`        // legiscan.com
+	StateLink   string    `json:"state_link"` // the legislature website
+	Status      int       `json:"status"`
+	StatusDate  Date      `json:"status_date"`
+	State       string    `json:"state"`
+	BillNumber  string    `json:"bill_number"`
+	BillType    string    `json:"bill_type"` // B, R, CR, JR, ...
+	Body        string    `json:"body"`      // S, H, A
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Committee   Committee `json:"committee"`
+	History     []History `json:"history"`
+	Sponsors    []Sponsor `json:"sponsors"`
+	Votes       []Vote    `json:"votes"`
+}
+
+// Committee is the pending committee; the API returns an empty array when there is none
+type Committee struct {
+	CommitteeID int    `json:"committee_id"`
+	Chamber     string `json:"chamber"`
+	Name        string `json:"name"`
+}
+
+func (c *Committee) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		return nil
+	}
+	type committee Committee
+	return json.Unmarshal(data, (*committee)(c))
+}
+
+type History struct {
+	Date       Date   `json:"date"`
+	Action     string `json:"action"`
+	Chamber    string `json:"chamber"`
+	Importance int    `json:"importance"`
+}
+
+const (
+	PrimarySponsor = 1
+	CoSponsor      = 2
+	JointSponsor   = 3
+)
+
+type Sponsor struct {
+	PeopleID         int    `json:"people_id"`
+	Name             string `json:"name"`
+	FirstName        string `json:"first_name"`
+	LastName         string `json:"last_name"`
+	Party            string `json:"party"`
+	Role             string `json:"role"` // Sen, Rep
+	District         string `json:"district"`
+	SponsorTypeID    int    `json:"sponsor_type_id"`
+	SponsorOrder     int    `json:"sponsor_order"`
+	CommitteeSponsor int    `json:"committee_sponsor"`
+}
+
+// Vote is a roll call as listed on a bill
+type Vote struct {
+	RollCallID int    `json:"roll_call_id"`
+	Date       Date   `json:"date"`
+	Desc       string `json:"desc"`
+	Yea        int    `json:"yea"`
+	Nay        int    `json:"nay"`
+	NV         int    `json:"nv"`
+	Absent     int    `json:"absent"`
+	Passed     int    `json:"passed"`
+	Chamber    string `json:"chamber"`
+}
+
+type RollCall struct {
+	Vote
+	BillID int          `json:"bill_id"`
+	Votes  []PersonVote `json:"votes"`
+}
+
+type PersonVote struct {
+	PeopleID int    `json:"people_id"`
+	VoteID   int    `json:"vote_id"`
+	VoteText string `json:"vote_text"` // Yea, Nay, NV, Absent
+}
+
+type Person struct {
+	PeopleID  int    `json:"people_id"`
+	Name      string `json:"name"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Party     string `json:"party"`
+	Role      string `json:"role"`
+	District  string `json:"district"`
+}
+
+type SearchResult struct {
+	Relevance    int    `json:"relevance"`
+	BillID       int    `json:"bill_id"`
+	BillNumber   string `json:"bill_number"`
+	State        string `json:"state"`
+	Title        string `json:"title"`
+	URL          string `json:"url"`
+	LastAction   string `json:"last_action"`
+	LastActionAt Date   `json:"last_action_date"`
+}
+
+// Date is a YYYY-MM-DD date; "0000-00-00" is the zero value
+type Date struct {
+	time.Time
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" || s == "0000-00-00" {
+		return nil
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return err
+	}
+	d.Time = t
+	return nil
+}
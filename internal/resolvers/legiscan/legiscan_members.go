@@ -0,0 +1,52 @@
+package legiscan
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/gosimple/slug"
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+// findSession returns the regular LegiScan session that overlaps session (or nil)
+func findSession(sessions []Session, session legislature.Session) *Session {
+	for _, s := range sessions {
+		if s.Special != 0 {
+			continue
+		}
+		if s.YearStart <= session.EndYear && s.YearEnd >= session.StartYear {
+			return &s
+		}
+	}
+	return nil
+}
+
+func (l LegiScan) Members(ctx context.Context, session legislature.Session) ([]legislature.Member, error) {
+	sessions, err := l.api.GetSessionList(ctx, l.state)
+	if err != nil {
+		return nil, err
+	}
+	s := findSession(sessions, session)
+	if s == nil {
+		return nil, nil
+	}
+	people, err := l.api.GetSessionPeople(ctx, s.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	var members []legislature.Member
+	for _, p := range people {
+		members = append(members, legislature.Member{
+			NumericID: p.PeopleID,
+			FullName:  strings.TrimSpace(p.Name),
+			ShortName: p.LastName,
+			Slug:      slug.MakeLang(p.Name, "en"),
+			URL:       l.memberURL(p.PeopleID, p.Name),
+			District:  p.District,
+			Party:     p.Party,
+		})
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].FullName < members[j].FullName })
+	return members, nil
+}
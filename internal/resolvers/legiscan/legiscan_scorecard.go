@@ -0,0 +1,84 @@
+package legiscan
+
+import (
+	"context"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+	"golang.org/x/sync/errgroup"
+)
+
+func (l LegiScan) Scorecard(ctx context.Context, bookmarks []legislature.Scorable) (*legislature.Scorecard, error) {
+	s := &legislature.Scorecard{
+		Body: &l.body,
+		Metadata: legislature.ScorecardMetadata{
+			PersonTitle: l.body.MemberName,
+		},
+		Data: make([]legislature.ScoredBookmark, len(bookmarks)),
+	}
+
+	sessions, err := l.api.GetSessionList(ctx, l.state)
+	if err != nil {
+		return s, err
+	}
+	year := time.Now().UTC().Year()
+	current := findSession(sessions, legislature.Session{StartYear: year, EndYear: year})
+	if current == nil {
+		return s, nil
+	}
+	people, err := l.api.GetSessionPeople(ctx, current.SessionID)
+	if err != nil {
+		return s, err
+	}
+	for _, p := range people {
+		s.People = append(s.People, legislature.ScorecardPerson{
+			ID:       p.PeopleID,
+			FullName: p.Name,
+			Party:    p.Party,
+			URL:      l.memberURL(p.PeopleID, p.Name),
+			District: p.District,
+		})
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(5)
+	for i, b := range bookmarks {
+		g.Go(func() error {
+			sb := b.NewScore()
+			billID, err := BillID(sb.Legislation.ID)
+			if err != nil {
+				return err
+			}
+			bill, err := l.api.GetBill(ctx, billID)
+			if err != nil {
+				return err
+			}
+			sb.Status = bill.StatusName()
+			sb.Committee = bill.Committee.Name
+
+			scores := make(map[int]string)
+			for _, sponsor := range bill.Sponsors {
+				scores[sponsor.PeopleID] = "Sponsor"
+				if sponsor.SponsorTypeID == PrimarySponsor {
+					scores[sponsor.PeopleID] = string(legislature.PrimeSponsor)
+				}
+			}
+			rollCalls, err := l.rollCalls(ctx, *bill, people)
+			if err != nil {
+				return err
+			}
+			for _, r := range rollCalls {
+				for _, v := range r.Votes {
+					scores[v.Member.NumericID] = string(v.Position)
+				}
+			}
+
+			for _, p := range s.People {
+				sb.Scores = append(sb.Scores, legislature.Score{Status: scores[p.ID], Desired: !sb.Oppose})
+			}
+			s.Data[i] = sb
+			return nil
+		})
+	}
+	return s, g.Wait()
+}
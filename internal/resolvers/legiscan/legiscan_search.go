@@ -0,0 +1,111 @@
+package legiscan
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+// currentSession is the LegiScan getSearch year for the current session
+const currentSession = 2
+
+const searchLimit = 20
+
+// Search is a LegiScan full text search of the state
+//
+// Results are not expanded with GetBill (to conserve API queries) so they only
+// include the bill number, title and last action
+func (l LegiScan) Search(ctx context.Context, query string, session legislature.Session) ([]legislature.Legislation, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, nil
+	}
+	year := currentSession
+	if session.StartYear != 0 {
+		year = session.StartYear
+	}
+	results, err := l.api.SearchText(ctx, l.state, query, year)
+	if err != nil {
+		return nil, err
+	}
+	var out []legislature.Legislation
+	for _, r := range results {
+		if r.BillID == 0 {
+			continue
+		}
+		out = append(out, l.searchResultLegislation(r))
+		if len(out) == searchLimit {
+			break
+		}
+	}
+	return out, nil
+}
+
+// SearchStates is a LegiScan full text search of the current session in the state of each resolver.
+// States that share an API key are a single state=ALL query (to conserve API queries) limited to those states
+func SearchStates(ctx context.Context, query string, l []*LegiScan) ([]legislature.Legislation, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, nil
+	}
+	var out []legislature.Legislation
+	var errs []error
+	for _, group := range groupByKey(l) {
+		if len(group) == 1 {
+			results, err := group[0].Search(ctx, query, legislature.Session{})
+			out, errs = append(out, results...), append(errs, err)
+			continue
+		}
+		results, err := group[0].api.SearchText(ctx, "ALL", query, currentSession)
+		out, errs = append(out, searchStateResults(results, group)...), append(errs, err)
+	}
+	return out, errors.Join(errs...)
+}
+
+// groupByKey groups resolvers by their API key (in order)
+func groupByKey(l []*LegiScan) [][]*LegiScan {
+	var out [][]*LegiScan
+	index := make(map[string]int)
+	for _, ll := range l {
+		i, ok := index[ll.api.key]
+		if !ok {
+			i = len(out)
+			index[ll.api.key] = i
+			out = append(out, nil)
+		}
+		out[i] = append(out[i], ll)
+	}
+	return out
+}
+
+// searchStateResults keeps up to searchLimit results for each state of l
+func searchStateResults(results []SearchResult, l []*LegiScan) []legislature.Legislation {
+	states := make(map[string]*LegiScan, len(l))
+	for _, ll := range l {
+		states[ll.state] = ll
+	}
+	count := make(map[string]int)
+	var out []legislature.Legislation
+	for _, r := range results {
+		state := strings.ToUpper(r.State)
+		ll, ok := states[state]
+		if !ok || r.BillID == 0 || count[state] == searchLimit {
+			continue
+		}
+		count[state]++
+		out = append(out, ll.searchResultLegislation(r))
+	}
+	return out
+}
+
+func (l LegiScan) searchResultLegislation(r SearchResult) legislature.Legislation {
+	return legislature.Legislation{
+		Body:         l.body.ID,
+		ID:           LegislationID(Bill{BillID: r.BillID, BillNumber: r.BillNumber}),
+		DisplayID:    r.BillNumber,
+		Title:        r.Title,
+		URL:          r.URL,
+		Status:       r.LastAction,
+		LastModified: r.LastActionAt.Time,
+	}
+}
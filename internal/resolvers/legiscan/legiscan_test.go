@@ -0,0 +1,186 @@
+package legiscan
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+const testBill = `{"status":"OK","bill":{
+	"bill_id":1811234,"session_id":2030,
+	"session":{"session_id":2030,"state_id":30,"year_start":2024,"year_end":2025,"special":0,"session_name":"2024-2025 Regular Session"},
+	"url":"https://legiscan.com/NJ/bill/S1234/2024","state_link":"https://www.njleg.state.nj.us/bill-search/2024/S1234",
+	"status":1,"status_date":"2024-01-09","state":"NJ","bill_number":"S1234","bill_type":"B","body":"S",
+	"title":"Requires bus lanes","description":"Requires bus lanes","committee":[],
+	"history":[{"date":"2024-01-09","action":"Introduced in the Senate, Referred to Senate Transportation Committee","chamber":"S","importance":1},
+		{"date":"2024-03-11","action":"Reported from Senate Committee","chamber":"S","importance":0}],
+	"sponsors":[{"people_id":20,"name":"John Roe","last_name":"Roe","party":"R","district":"SD-002","sponsor_type_id":2,"sponsor_order":2,"committee_sponsor":0},
+		{"people_id":10,"name":"Jane Doe","last_name":"Doe","party":"D","district":"SD-001","sponsor_type_id":1,"sponsor_order":1,"committee_sponsor":0},
+		{"people_id":0,"name":"Transportation Committee","sponsor_type_id":1,"sponsor_order":3,"committee_sponsor":1}],
+	"votes":[{"roll_call_id":99,"date":"2024-03-11","desc":"Senate Transportation Committee: Reported Favorably","yea":4,"nay":1,"passed":1,"chamber":"S"}]
+}}`
+
+func TestNewLegislation(t *testing.T) {
+	var resp struct {
+		Bill Bill `json:"bill"`
+	}
+	if err := decode("getBill", []byte(testBill), &resp); err != nil {
+		t.Fatal(err)
+	}
+	l := New(legislature.Body{ID: "nj-legislature"}, "NJ", "").NewLegislation(resp.Bill)
+	if l.ID != "1811234_S1234" || l.DisplayID != "S1234" {
+		t.Errorf("got ID %q DisplayID %q", l.ID, l.DisplayID)
+	}
+	if l.Session != (legislature.Session{StartYear: 2024, EndYear: 2025}) {
+		t.Errorf("got session %v", l.Session)
+	}
+	if l.Status != "Introduced" {
+		t.Errorf("got status %q", l.Status)
+	}
+	if l.URL != "https://www.njleg.state.nj.us/bill-search/2024/S1234" {
+		t.Errorf("got URL %q", l.URL)
+	}
+	if l.Description != "" {
+		t.Errorf("expected duplicate description to be removed got %q", l.Description)
+	}
+	if !l.IntroducedDate.Equal(time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("got IntroducedDate %s", l.IntroducedDate)
+	}
+	if len(l.Sponsors) != 2 {
+		t.Fatalf("got %d sponsors expected 2", len(l.Sponsors))
+	}
+	if l.Sponsors[0].NumericID != 10 || !l.Sponsors[0].IsPrime() || l.Sponsors[1].Role != legislature.Cosponsor {
+		t.Errorf("got sponsors %#v", l.Sponsors)
+	}
+	if len(l.Actions) != 2 || l.Actions[0].Text != "Senate Introduced in the Senate, Referred to Senate Transportation Committee" {
+		t.Errorf("got actions %#v", l.Actions)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	var v struct{}
+	err := decode("getBill", []byte(`{"status":"ERROR","alert":{"message":"Unknown bill id"}}`), &v)
+	if err == nil || err.Error() != "legiscan getBill error: ERROR Unknown bill id" {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestLegislationID(t *testing.T) {
+	l := New(legislature.Body{ID: "nj-legislature"}, "NJ", "")
+	id := LegislationID(Bill{BillID: 1811234, BillNumber: "A5678"})
+	if id != "1811234_A5678" {
+		t.Errorf("got %q", id)
+	}
+	if got := l.DisplayID(id); got != "A5678" {
+		t.Errorf("DisplayID got %q", got)
+	}
+	if got, err := BillID(id); err != nil || got != 1811234 {
+		t.Errorf("BillID got %d %v", got, err)
+	}
+	if _, err := BillID("A5678"); err == nil {
+		t.Errorf("expected error")
+	}
+}
+
+func TestRollCall(t *testing.T) {
+	l := New(legislature.Body{ID: "nj-legislature"}, "NJ", "")
+	rc := RollCall{
+		Vote: Vote{Desc: "Senate Transportation Committee: Reported Favorably", Passed: 1, Chamber: "S"},
+		Votes: []PersonVote{
+			{PeopleID: 10, VoteText: "Yea"},
+			{PeopleID: 20, VoteText: "Nay"},
+			{PeopleID: 30, VoteText: "NV"},
+		},
+	}
+	r := l.rollCall(rc, map[int]Person{10: {PeopleID: 10, Name: "Jane Doe"}})
+	if r.Committee != "Senate Transportation Committee" || r.Motion != "Reported Favorably" || r.Chamber != "Senate" || r.Result != "Passed" {
+		t.Errorf("got %#v", r)
+	}
+	if r.Tally != (legislature.VoteTally{Aye: 1, Nay: 1, Other: 1}) {
+		t.Errorf("got tally %v", r.Tally)
+	}
+	if r.Votes[2].Position != legislature.NotVoting {
+		t.Errorf("got position %q", r.Votes[2].Position)
+	}
+
+	floor := l.rollCall(RollCall{Vote: Vote{Desc: "3RDG FINAL PASSAGE", Chamber: "S"}}, nil)
+	if !floor.IsFloor() || floor.Result != "Failed" {
+		t.Errorf("got %#v", floor)
+	}
+}
+
+func TestSearchResults(t *testing.T) {
+	var resp struct {
+		SearchResult map[string]json.RawMessage `json:"searchresult"`
+	}
+	err := decode("getSearch", []byte(`{"status":"OK","searchresult":{
+		"summary":{"page":"1 of 1","range":"1 - 2","relevancy":"100% - 40%","count":2,"page_current":1,"page_total":1},
+		"0":{"relevance":100,"state":"NJ","bill_number":"S1234","bill_id":1811234,"change_hash":"x","url":"https://legiscan.com/NJ/bill/S1234/2024","text_url":"","research_url":"","last_action_date":"2024-03-11","last_action":"Reported from Senate Committee","title":"Requires bus lanes"},
+		"1":{"relevance":40,"state":"NJ","bill_number":"A5678","bill_id":1815678,"change_hash":"y","url":"https://legiscan.com/NJ/bill/A5678/2024","text_url":"","research_url":"","last_action_date":"0000-00-00","last_action":"Introduced","title":"Bus lane cameras"}
+	}}`), &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := searchResults(resp.SearchResult)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results", len(results))
+	}
+	l := New(legislature.Body{ID: "nj-legislature"}, "NJ", "")
+	for _, r := range results {
+		if r.BillID != 1811234 {
+			continue
+		}
+		got := l.searchResultLegislation(r)
+		if got.ID != "1811234_S1234" || got.URL != "https://legiscan.com/NJ/bill/S1234/2024" || got.Title != "Requires bus lanes" {
+			t.Errorf("got %#v", got)
+		}
+		if got.LastModified.Format("2006-01-02") != "2024-03-11" {
+			t.Errorf("LastModified got %s", got.LastModified)
+		}
+	}
+}
+
+func TestSearchStateResults(t *testing.T) {
+	nj := New(legislature.Body{ID: "nj-legislature"}, "NJ", "")
+	pa := New(legislature.Body{ID: "pa-legislature"}, "PA", "")
+	results := []SearchResult{
+		{BillID: 1, BillNumber: "S1", State: "NJ"},
+		{BillID: 2, BillNumber: "H2", State: "PA"},
+		{BillID: 3, BillNumber: "S3", State: "CA"},
+		{BillNumber: "S4", State: "NJ"},
+	}
+	got := searchStateResults(results, []*LegiScan{nj, pa})
+	var ids []string
+	for _, l := range got {
+		ids = append(ids, string(l.Body)+" "+string(l.ID))
+	}
+	expected := []string{"nj-legislature 1_S1", "pa-legislature 2_H2"}
+	if strings.Join(ids, ",") != strings.Join(expected, ",") {
+		t.Errorf("got %v expected %v", ids, expected)
+	}
+}
+
+func TestGroupByKey(t *testing.T) {
+	nj := New(legislature.Body{ID: "nj-legislature"}, "NJ", "a")
+	pa := New(legislature.Body{ID: "pa-legislature"}, "PA", "b")
+	ca := New(legislature.Body{ID: "ca-legislature"}, "CA", "a")
+	got := groupByKey([]*LegiScan{nj, pa, ca})
+	var groups []string
+	for _, g := range got {
+		var states []string
+		for _, l := range g {
+			states = append(states, l.state)
+		}
+		groups = append(groups, strings.Join(states, " "))
+	}
+	expected := []string{"NJ CA", "PA"}
+	if strings.Join(groups, ",") != strings.Join(expected, ",") {
+		t.Errorf("got %v expected %v", groups, expected)
+	}
+}
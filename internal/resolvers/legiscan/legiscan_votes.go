@@ -0,0 +1,78 @@
+package legiscan
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+// Votes returns the committee and floor roll calls for a bill
+func (l LegiScan) Votes(ctx context.Context, id legislature.LegislationID) ([]legislature.RollCall, error) {
+	billID, err := BillID(id)
+	if err != nil {
+		return nil, err
+	}
+	bill, err := l.api.GetBill(ctx, billID)
+	if err != nil {
+		return nil, err
+	}
+	var people []Person
+	if len(bill.Votes) > 0 {
+		people, err = l.api.GetSessionPeople(ctx, bill.SessionID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return l.rollCalls(ctx, *bill, people)
+}
+
+func (l LegiScan) rollCalls(ctx context.Context, bill Bill, people []Person) ([]legislature.RollCall, error) {
+	peopleByID := make(map[int]Person, len(people))
+	for _, p := range people {
+		peopleByID[p.PeopleID] = p
+	}
+	var out []legislature.RollCall
+	for _, v := range bill.Votes {
+		rc, err := l.api.GetRollCall(ctx, v.RollCallID)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, l.rollCall(*rc, peopleByID))
+	}
+	legislature.SortRollCalls(out)
+	return out, nil
+}
+
+func (l LegiScan) rollCall(rc RollCall, people map[int]Person) legislature.RollCall {
+	r := legislature.RollCall{
+		Date:    rc.Date.Time,
+		Chamber: chamberName(rc.Chamber),
+		Motion:  rc.Desc,
+		Result:  "Failed",
+	}
+	if rc.Passed == 1 {
+		r.Result = "Passed"
+	}
+	// committee votes are described as "Senate Budget and Appropriations Committee: Reported Favorably"
+	if name, motion, ok := strings.Cut(rc.Desc, ":"); ok && strings.Contains(name, "Committee") {
+		r.Committee = strings.TrimSpace(name)
+		r.Motion = strings.TrimSpace(motion)
+	}
+	for _, v := range rc.Votes {
+		p := people[v.PeopleID]
+		r.Votes = append(r.Votes, legislature.MemberVote{
+			Member: legislature.Member{
+				NumericID: v.PeopleID,
+				FullName:  p.Name,
+				ShortName: p.LastName,
+				URL:       l.memberURL(v.PeopleID, p.Name),
+				Party:     p.Party,
+				District:  p.District,
+			},
+			Position: legislature.NormalizeVotePosition(v.VoteText),
+		})
+	}
+	r.Tally = legislature.TallyVotes(r.Votes)
+	return r
+}
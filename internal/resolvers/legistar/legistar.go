@@ -0,0 +1,225 @@
+// Package legistar is a resolver for city councils that publish legislation with Legistar
+//
+// See http://webapi.legistar.com/
+package legistar
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+	legistarapi "github.com/jehiah/legislator/legistar"
+)
+
+// Config identifies a Legistar client
+type Config struct {
+	Client   string // i.e. "chicago" in https://webapi.legistar.com/v1/chicago
+	Token    string // optional; only some clients require an API token
+	Host     string // i.e. "chicago.legistar.com"
+	BodyName string // the legislative body members hold office in; i.e. "City Council"
+
+	// Calendar has the terms of the council. Years not on the calendar are a one year session
+	Calendar legislature.Calendar
+}
+
+type Legistar struct {
+	body   legislature.Body
+	config Config
+	api    *legistarapi.Client
+}
+
+func New(b legislature.Body, c Config) *Legistar {
+	return &Legistar{
+		body:   b,
+		config: c,
+		api:    legistarapi.NewClient(c.Client, c.Token),
+	}
+}
+
+func (l Legistar) Body() legislature.Body { return l.body }
+
+func (l Legistar) SupportedDomains() []string {
+	return []string{l.config.Host}
+}
+
+// session returns the session for the year legislation was introduced
+func (l Legistar) session(year int) legislature.Session {
+	if s := l.config.Calendar.Find(year); s != (legislature.Session{}) {
+		return s
+	}
+	return legislature.Session{StartYear: year, EndYear: year}
+}
+
+// LegislationID is the MatterID and File i.e. "12345_O2023-1234" for MatterID 12345 File "O2023-1234"
+//
+// The MatterID is needed to call the API and the File is needed for DisplayID
+func LegislationID(m legistarapi.Matter) legislature.LegislationID {
+	file := strings.NewReplacer(" ", "_", "/", "_").Replace(strings.TrimSpace(m.File))
+	return legislature.LegislationID(fmt.Sprintf("%d_%s", m.ID, file))
+}
+
+// MatterID returns the MatterID from a LegislationID
+func MatterID(id legislature.LegislationID) (int, error) {
+	matterID, _, _ := strings.Cut(string(id), "_")
+	n, err := strconv.Atoi(matterID)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid Legistar LegislationID %q", id)
+	}
+	return n, nil
+}
+
+// DisplayID returns the File: 12345_O2023-1234 => O2023-1234
+func (l Legistar) DisplayID(id legislature.LegislationID) string {
+	_, file, _ := strings.Cut(string(id), "_")
+	return strings.ReplaceAll(file, "_", " ")
+}
+
+// Link returns a Legistar gateway URL which redirects to the LegislationDetail page
+func (l Legistar) Link(id legislature.LegislationID) *url.URL {
+	matterID, _ := MatterID(id)
+	return &url.URL{
+		Scheme:   "https",
+		Host:     l.config.Host,
+		Path:     "/gateway.aspx",
+		RawQuery: url.Values{"M": []string{"L"}, "ID": []string{strconv.Itoa(matterID)}}.Encode(),
+	}
+}
+
+// matterIDFromURL supports LegislationDetail.aspx?ID=1234&GUID=... and gateway.aspx?M=L&ID=1234 URLs
+func matterIDFromURL(u *url.URL) int {
+	q := u.Query()
+	switch strings.ToLower(u.Path) {
+	case "/legislationdetail.aspx":
+	case "/gateway.aspx":
+		if !strings.EqualFold(q.Get("M"), "L") && !strings.EqualFold(q.Get("m"), "l") {
+			return 0
+		}
+	default:
+		return 0
+	}
+	id := q.Get("ID")
+	if id == "" {
+		id = q.Get("id")
+	}
+	n, _ := strconv.Atoi(id)
+	return n
+}
+
+func (l Legistar) Lookup(ctx context.Context, u *url.URL) (*legislature.Legislation, error) {
+	if !strings.EqualFold(u.Hostname(), l.config.Host) {
+		return nil, nil
+	}
+	matterID := matterIDFromURL(u)
+	if matterID == 0 {
+		return nil, nil
+	}
+	leg, err := l.get(ctx, matterID)
+	if err == legislature.ErrNotFound {
+		return nil, nil
+	}
+	return leg, err
+}
+
+func (l Legistar) Refresh(ctx context.Context, id legislature.LegislationID) (*legislature.Legislation, error) {
+	matterID, err := MatterID(id)
+	if err != nil {
+		return nil, err
+	}
+	return l.get(ctx, matterID)
+}
+
+func (l Legistar) get(ctx context.Context, matterID int) (*legislature.Legislation, error) {
+	m, err := l.api.Matter(ctx, matterID)
+	if err != nil {
+		if legistarapi.IsNotFoundError(err) {
+			return nil, legislature.ErrNotFound
+		}
+		return nil, err
+	}
+	if m.ID == 0 || m.RestrictViewViaWeb {
+		return nil, legislature.ErrNotFound
+	}
+	sponsors, err := l.api.MatterSponsors(ctx, matterID)
+	if err != nil {
+		return nil, err
+	}
+	history, err := l.api.MatterHistories(ctx, matterID)
+	if err != nil {
+		return nil, err
+	}
+	return l.NewLegislation(m, sponsors, history), nil
+}
+
+func (l Legistar) NewLegislation(m legistarapi.Matter, sponsors legistarapi.MatterSponsors, history legistarapi.MatterHistories) *legislature.Legislation {
+	legType := legislature.BillType
+	if strings.Contains(strings.ToLower(m.TypeName), "resolution") {
+		legType = legislature.ResolutionType
+	}
+
+	var members []legislature.Member
+	seen := make(map[int]bool)
+	for _, s := range sponsors {
+		// sponsors are listed for each version of the matter
+		if s.NameID == 0 || seen[s.NameID] {
+			continue
+		}
+		seen[s.NameID] = true
+		role := legislature.Cosponsor
+		if len(members) == 0 {
+			// the prime sponsor is listed first
+			role = legislature.PrimeSponsor
+		}
+		members = append(members, legislature.Member{
+			NumericID: s.NameID,
+			FullName:  strings.TrimSpace(s.Name),
+			Slug:      s.Slug(),
+			Role:      role,
+		})
+	}
+
+	var actions []legislature.Action
+	for _, h := range history {
+		text := h.ActionName
+		if h.PassedFlagName != "" {
+			text += " (" + h.PassedFlagName + ")"
+		}
+		action := legislature.Action{
+			Date: h.ActionDate.Time,
+			Text: text,
+			Kind: legislature.ActionKindFromText(h.ActionName),
+		}
+		if committee, ok := strings.CutPrefix(h.ActionBodyName, "Committee on "); ok {
+			action.Committee = committee
+		}
+		actions = append(actions, action)
+	}
+
+	id := LegislationID(m)
+	return &legislature.Legislation{
+		Body:           l.body.ID,
+		ID:             id,
+		DisplayID:      strings.TrimSpace(m.File),
+		Title:          firstNonEmpty(m.Name, m.Title),
+		Summary:        m.Title,
+		IntroducedDate: m.IntroDate.Time,
+		Session:        l.session(m.IntroDate.Year()),
+		Status:         m.StatusName,
+		Actions:        actions,
+		Type:           legType,
+		Sponsors:       members,
+		LastModified:   m.LastModified.Time,
+		URL:            l.Link(id).String(),
+	}
+}
+
+func firstNonEmpty(s ...string) string {
+	for _, ss := range s {
+		if ss = strings.TrimSpace(ss); ss != "" {
+			return ss
+		}
+	}
+	return ""
+}
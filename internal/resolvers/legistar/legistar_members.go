@@ -0,0 +1,72 @@
+package legistar
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gosimple/slug"
+	"github.com/jehiah/legislation.support/internal/legislature"
+	legistarapi "github.com/jehiah/legislator/legistar"
+)
+
+// Members returns the people who held office in the configured body during the session
+func (l Legistar) Members(ctx context.Context, session legislature.Session) ([]legislature.Member, error) {
+	records, err := l.api.OfficeRecords(ctx, officeRecordBodyFilter(l.config.BodyName))
+	if err != nil {
+		return nil, err
+	}
+	persons, err := l.api.Persons(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	personByID := make(map[int]legistarapi.Person, len(persons))
+	for _, p := range persons {
+		personByID[p.ID] = p
+	}
+
+	seen := make(map[int]bool)
+	var members []legislature.Member
+	for _, r := range records {
+		end := r.EndDate.Time
+		if end.IsZero() {
+			end = time.Now().UTC()
+		}
+		if seen[r.PersonID] || !session.Overlaps(r.StartDate.Time, end) {
+			continue
+		}
+		seen[r.PersonID] = true
+		p := personByID[r.PersonID]
+		m := legislature.Member{
+			NumericID: r.PersonID,
+			FullName:  strings.TrimSpace(r.FullName),
+			Slug:      slug.MakeLang(r.FullName, "en"),
+		}
+		if p.GUID != "" {
+			m.URL = l.personURL(p)
+		}
+		members = append(members, m)
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].FullName < members[j].FullName })
+	return members, nil
+}
+
+// officeRecordBodyFilter limits OfficeRecords to a legislative body
+type officeRecordBodyFilter string
+
+func (f officeRecordBodyFilter) Paramters() url.Values {
+	return legistarapi.StringFilter("OfficeRecordBodyName", string(f))
+}
+
+func (l Legistar) personURL(p legistarapi.Person) string {
+	u := url.URL{
+		Scheme:   "https",
+		Host:     l.config.Host,
+		Path:     "/PersonDetail.aspx",
+		RawQuery: url.Values{"ID": []string{strconv.Itoa(p.ID)}, "GUID": []string{p.GUID}}.Encode(),
+	}
+	return u.String()
+}
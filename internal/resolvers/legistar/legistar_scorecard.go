@@ -0,0 +1,90 @@
+package legistar
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+	"golang.org/x/sync/errgroup"
+)
+
+func (l Legistar) Scorecard(ctx context.Context, bookmarks []legislature.Scorable) (*legislature.Scorecard, error) {
+	s := &legislature.Scorecard{
+		Body: &l.body,
+		Metadata: legislature.ScorecardMetadata{
+			PersonTitle: l.body.MemberName,
+		},
+		Data: make([]legislature.ScoredBookmark, len(bookmarks)),
+	}
+
+	members, err := l.Members(ctx, l.session(time.Now().UTC().Year()))
+	if err != nil {
+		return s, err
+	}
+	for _, m := range members {
+		s.People = append(s.People, legislature.ScorecardPerson{
+			ID:       m.NumericID,
+			FullName: m.FullName,
+			URL:      m.URL,
+			District: m.District,
+		})
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(5)
+	for i, b := range bookmarks {
+		g.Go(func() error {
+			sb := b.NewScore()
+			matterID, err := MatterID(sb.Legislation.ID)
+			if err != nil {
+				return err
+			}
+			m, err := l.api.Matter(ctx, matterID)
+			if err != nil {
+				return err
+			}
+			sb.Status = m.StatusName
+			if !strings.EqualFold(m.BodyName, l.config.BodyName) {
+				sb.Committee = strings.TrimPrefix(m.BodyName, "Committee on ")
+			}
+
+			sponsors, err := l.api.MatterSponsors(ctx, matterID)
+			if err != nil {
+				return err
+			}
+			scores := make(map[int]string)
+			for i, sponsor := range sponsors {
+				if _, ok := scores[sponsor.NameID]; ok {
+					continue
+				}
+				scores[sponsor.NameID] = "Sponsor"
+				if i == 0 {
+					// the prime sponsor is listed first
+					scores[sponsor.NameID] = string(legislature.PrimeSponsor)
+				}
+			}
+
+			history, err := l.api.MatterHistories(ctx, matterID)
+			if err != nil {
+				return err
+			}
+			rollCalls, err := l.rollCalls(ctx, history)
+			if err != nil {
+				return err
+			}
+			for _, r := range rollCalls {
+				for _, v := range r.Votes {
+					scores[v.Member.NumericID] = string(v.Position)
+				}
+			}
+
+			for _, p := range s.People {
+				sb.Scores = append(sb.Scores, legislature.Score{Status: scores[p.ID], Desired: !sb.Oppose})
+			}
+			s.Data[i] = sb
+			return nil
+		})
+	}
+	return s, g.Wait()
+}
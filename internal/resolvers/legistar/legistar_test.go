@@ -0,0 +1,105 @@
+package legistar
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+	legistarapi "github.com/jehiah/legislator/legistar"
+)
+
+var testResolver = New(legislature.Body{ID: "chicago"}, Config{
+	Client:   "chicago",
+	Host:     "chicago.legistar.com",
+	BodyName: "City Council",
+	Calendar: legislature.Calendar{Terms: []legislature.Term{{Session: legislature.Session{StartYear: 2023, EndYear: 2027}}}},
+})
+
+func TestLegislationID(t *testing.T) {
+	type testCase struct {
+		matter    legistarapi.Matter
+		expected  legislature.LegislationID
+		displayID string
+	}
+	tests := []testCase{
+		{legistarapi.Matter{ID: 12345, File: "O2023-1234"}, "12345_O2023-1234", "O2023-1234"},
+		{legistarapi.Matter{ID: 678, File: "CB 120345"}, "678_CB_120345", "CB 120345"},
+		{legistarapi.Matter{ID: 91, File: "Res 12/3"}, "91_Res_12_3", "Res 12 3"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.matter.File, func(t *testing.T) {
+			id := LegislationID(tc.matter)
+			if id != tc.expected {
+				t.Errorf("got %q expected %q", id, tc.expected)
+			}
+			if got := testResolver.DisplayID(id); got != tc.displayID {
+				t.Errorf("DisplayID got %q expected %q", got, tc.displayID)
+			}
+			if got, err := MatterID(id); err != nil || got != tc.matter.ID {
+				t.Errorf("MatterID got %d %v expected %d", got, err, tc.matter.ID)
+			}
+		})
+	}
+	if _, err := MatterID("O2023-1234"); err == nil {
+		t.Errorf("expected error for invalid ID")
+	}
+}
+
+func TestMatterIDFromURL(t *testing.T) {
+	type testCase struct {
+		u        string
+		expected int
+	}
+	tests := []testCase{
+		{"https://chicago.legistar.com/LegislationDetail.aspx?ID=6123456&GUID=ABC-123", 6123456},
+		{"https://chicago.legistar.com/gateway.aspx?M=L&ID=6123456", 6123456},
+		{"https://chicago.legistar.com/gateway.aspx?m=l&id=6123456", 6123456},
+		{"https://chicago.legistar.com/gateway.aspx?M=F&ID=6123456", 0},
+		{"https://chicago.legistar.com/Legislation.aspx", 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.u, func(t *testing.T) {
+			u, err := url.Parse(tc.u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := matterIDFromURL(u); got != tc.expected {
+				t.Errorf("got %d expected %d", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNewLegislation(t *testing.T) {
+	intro := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	m := legistarapi.Matter{ID: 12345, File: "O2024-0001", Name: "Amendment of Municipal Code", Title: "Amendment of Municipal Code Chapter 9-64", TypeName: "Ordinance", StatusName: "In Committee"}
+	m.IntroDate.Time = intro
+	sponsors := legistarapi.MatterSponsors{
+		{NameID: 10, Name: "Jane Doe", Sequence: 0},
+		{NameID: 11, Name: "John Roe", Sequence: 1},
+		{NameID: 10, Name: "Jane Doe", Sequence: 2, MatterVersion: "1"},
+	}
+	history := legistarapi.MatterHistories{
+		{ActionName: "Referred", ActionBodyName: "Committee on Finance"},
+	}
+	l := testResolver.NewLegislation(m, sponsors, history)
+	if l.ID != "12345_O2024-0001" || l.DisplayID != "O2024-0001" {
+		t.Errorf("got ID %q DisplayID %q", l.ID, l.DisplayID)
+	}
+	if l.Session != (legislature.Session{StartYear: 2023, EndYear: 2027}) {
+		t.Errorf("got session %v", l.Session)
+	}
+	if len(l.Sponsors) != 2 {
+		t.Fatalf("got %d sponsors expected 2", len(l.Sponsors))
+	}
+	if p := l.PrimeSponsor(); p == nil || p.NumericID != 10 {
+		t.Errorf("got prime sponsor %#v", p)
+	}
+	if len(l.Actions) != 1 || l.Actions[0].Committee != "Finance" {
+		t.Errorf("got actions %#v", l.Actions)
+	}
+	if l.URL != "https://chicago.legistar.com/gateway.aspx?ID=12345&M=L" {
+		t.Errorf("got URL %q", l.URL)
+	}
+}
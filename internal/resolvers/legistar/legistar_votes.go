@@ -0,0 +1,73 @@
+package legistar
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gosimple/slug"
+	"github.com/jehiah/legislation.support/internal/legislature"
+	legistarapi "github.com/jehiah/legislator/legistar"
+	log "github.com/sirupsen/logrus"
+)
+
+// Votes returns the committee and council votes on a matter
+func (l Legistar) Votes(ctx context.Context, id legislature.LegislationID) ([]legislature.RollCall, error) {
+	matterID, err := MatterID(id)
+	if err != nil {
+		return nil, err
+	}
+	history, err := l.api.MatterHistories(ctx, matterID)
+	if err != nil {
+		if legistarapi.IsNotFoundError(err) {
+			return nil, legislature.ErrNotFound
+		}
+		return nil, err
+	}
+	return l.rollCalls(ctx, history)
+}
+
+// rollCalls fetches the votes for each history entry with a recorded result
+func (l Legistar) rollCalls(ctx context.Context, history legistarapi.MatterHistories) ([]legislature.RollCall, error) {
+	var out []legislature.RollCall
+	for _, h := range history {
+		if h.PassedFlagName == "" {
+			continue
+		}
+		// the history ID is the EventItem ID
+		votes, err := l.api.EventVotes(ctx, h.ID)
+		if err != nil {
+			// older records can have a result without votes
+			log.WithField("client", l.config.Client).Warnf("error getting votes for history %d: %s", h.ID, err)
+			continue
+		}
+		if len(votes) == 0 {
+			continue
+		}
+		out = append(out, l.rollCall(h, votes))
+	}
+	legislature.SortRollCalls(out)
+	return out, nil
+}
+
+func (l Legistar) rollCall(h legistarapi.MatterHistory, votes legistarapi.Votes) legislature.RollCall {
+	r := legislature.RollCall{
+		Date:   h.ActionDate.Time,
+		Motion: h.ActionName,
+		Result: h.PassedFlagName,
+	}
+	if !strings.EqualFold(h.ActionBodyName, l.config.BodyName) {
+		r.Committee = strings.TrimPrefix(h.ActionBodyName, "Committee on ")
+	}
+	for _, v := range votes {
+		r.Votes = append(r.Votes, legislature.MemberVote{
+			Member: legislature.Member{
+				NumericID: v.PersonID,
+				FullName:  strings.TrimSpace(v.PersonName),
+				Slug:      slug.MakeLang(v.PersonName, "en"),
+			},
+			Position: legislature.NormalizeVotePosition(v.ValueName),
+		})
+	}
+	r.Tally = legislature.TallyVotes(r.Votes)
+	return r
+}
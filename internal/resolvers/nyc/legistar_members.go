@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jehiah/legislation.support/internal/legislature"
 	"github.com/jehiah/legislator/db"
@@ -14,7 +15,7 @@ func (a NYC) Members(ctx context.Context, session legislature.Session) ([]legisl
 
 	var allPeople []db.Person
 	var err error
-	if session.Active() {
+	if a.calendar.Active(session, time.Now()) {
 		allPeople, err = a.ActivePeople(ctx)
 	} else {
 		allPeople, err = a.AllPeople(ctx)
@@ -8,33 +8,38 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/jehiah/legislation.support/internal/legislature"
 	"github.com/jehiah/legislator/db"
 	log "github.com/sirupsen/logrus"
 )
 
-var Sessions = legislature.Sessions{
-	{StartYear: 2026, EndYear: 2029},
-	{StartYear: 2024, EndYear: 2025},
-	{StartYear: 2022, EndYear: 2023},
-	{StartYear: 2018, EndYear: 2021},
-	{StartYear: 2014, EndYear: 2017},
-	{StartYear: 2010, EndYear: 2013},
-	{StartYear: 2006, EndYear: 2009},
-	{StartYear: 2004, EndYear: 2005},
-	{StartYear: 2002, EndYear: 2003},
-	{StartYear: 1998, EndYear: 2001},
+// DefaultCalendar is the council session calendar used when a body does not have a Calendar.
+// Council terms are four years except after each census when there are two, two year terms
+// (i.e. 2022-2023, 2024-2025). The Mayor has 30 days to act on legislation passed at the end of a session.
+var DefaultCalendar = legislature.Calendar{
+	FirstYear: 2002,
+	Lengths:   []int{2, 2, 4, 4, 4, 4},
+	LameDuck:  30 * 24 * time.Hour,
+	Terms: []legislature.Term{
+		{Session: legislature.Session{StartYear: 1998, EndYear: 2001}},
+	},
 }
 
-// var CurrentSession = Sessions.Current()
-
 type NYC struct {
-	body legislature.Body
+	body     legislature.Body
+	calendar legislature.Calendar
+	index    *searchIndex // optional; see WithSearchIndex
 }
 
+// New returns a resolver for the body; sessions are from the body's Calendar (or DefaultCalendar)
 func New(b legislature.Body) *NYC {
-	return &NYC{body: b}
+	n := &NYC{body: b, calendar: DefaultCalendar}
+	if b.Calendar != nil {
+		n.calendar = *b.Calendar
+	}
+	return n
 }
 
 func (n NYC) Body() legislature.Body { return n.body }
@@ -182,18 +187,37 @@ func (n NYC) NewLegislation(d *db.Legislation) *legislature.Legislation {
 	}
 
 	sponsors := make([]legislature.Member, 0, len(d.Sponsors))
-	for _, p := range d.Sponsors {
+	for i, p := range d.Sponsors {
 		if p.ID == 0 {
 			continue // borough president, etc
 		}
+		role := legislature.Cosponsor
+		if i == 0 {
+			// the prime sponsor is listed first
+			role = legislature.PrimeSponsor
+		}
 		sponsors = append(sponsors, legislature.Member{
 			NumericID: p.ID,
 			FullName:  strings.TrimSpace(p.FullName),
 			URL:       "https://intro.nyc/councilmembers/" + p.Slug,
 			Slug:      p.Slug,
+			Role:      role,
 		})
 	}
 
+	var actions []legislature.Action
+	for _, h := range d.History {
+		action := legislature.Action{
+			Date: h.Date,
+			Text: h.Action,
+			Kind: legislature.ActionKindFromText(h.Action),
+		}
+		if committee, ok := strings.CutPrefix(h.BodyName, "Committee on "); ok {
+			action.Committee = committee
+		}
+		actions = append(actions, action)
+	}
+
 	return &legislature.Legislation{
 		Body:           n.body.ID,
 		ID:             fileToLegislationID(d.File),
@@ -202,8 +226,9 @@ func (n NYC) NewLegislation(d *db.Legislation) *legislature.Legislation {
 		Summary:        d.Title,
 		Description:    d.Summary,
 		IntroducedDate: d.IntroDate,
-		Session:        Sessions.Find(d.IntroDate.Year()),
+		Session:        n.calendar.Find(d.IntroDate.Year()),
 		Status:         d.StatusName,
+		Actions:        actions,
 		Type:           legType,
 		Sponsors:       sponsors,
 		LastModified:   d.LastModified,
@@ -0,0 +1,32 @@
+package nyc
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+// i.e. "Int 123-2024", "Int. 0123-2024", "Intro 123-2024", "Res 12-2024" or "123-2024"
+var citationPattern = regexp.MustCompile(`(?i)^(int|intro|res)?\.?\s*(?:no\.?\s*)?([0-9]{1,4})-((?:19|20)[0-9]{2})$`)
+
+// ParseCitation parses an Introduction or Resolution file number
+//
+// The year is required as file numbers restart each session
+func (n NYC) ParseCitation(citation string, session legislature.Session) (legislature.LegislationID, bool) {
+	p := citationPattern.FindStringSubmatch(strings.TrimSpace(citation))
+	if p == nil {
+		return "", false
+	}
+	num, _ := strconv.Atoi(p[2])
+	if num == 0 {
+		return "", false
+	}
+	fileType := "Int"
+	if strings.EqualFold(p[1], "res") {
+		fileType = "Res"
+	}
+	return fileToLegislationID(fmt.Sprintf("%s %04d-%s", fileType, num, p[3])), true
+}
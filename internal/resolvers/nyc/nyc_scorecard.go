@@ -65,12 +65,16 @@ func (a NYC) Scorecard(ctx context.Context, bookmarks []legislature.Scorable) (*
 			sb.Status = raw.StatusName
 			sb.Committee = strings.TrimPrefix(raw.BodyName, "Committee on ")
 			scores := make(map[int]string)
-			for _, sponsor := range raw.Sponsors {
+			for i, sponsor := range raw.Sponsors {
 				scores[sponsor.ID] = "Sponsor"
+				if i == 0 {
+					// the prime sponsor is listed first
+					scores[sponsor.ID] = string(legislature.PrimeSponsor)
+				}
 			}
-			for _, h := range raw.History {
-				for _, v := range h.Votes {
-					scores[v.ID] = v.Vote
+			for _, r := range rollCalls(raw) {
+				for _, v := range r.Votes {
+					scores[v.Member.NumericID] = string(v.Position)
 				}
 			}
 
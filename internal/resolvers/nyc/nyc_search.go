@@ -0,0 +1,137 @@
+package nyc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+	"github.com/jehiah/legislator/db"
+	log "github.com/sirupsen/logrus"
+)
+
+// searchIndex is an in-memory index of legislation loaded from a local checkout of
+// https://github.com/jehiah/nyc_legislation ({dir}/introduction/{year}/*.json and {dir}/resolution/{year}/*.json)
+type searchIndex struct {
+	dir string
+
+	mu       sync.Mutex
+	sessions map[legislature.Session]indexedSession
+}
+
+type indexedSession struct {
+	loaded  time.Time
+	entries []indexEntry
+}
+
+type indexEntry struct {
+	text        string // lowercase File, Name and Title
+	legislation *legislature.Legislation
+}
+
+// indexTTL is how long an indexed session is used before it's reloaded (to pick up new legislation)
+const indexTTL = time.Hour
+
+const searchLimit = 20
+
+// WithSearchIndex enables Search with a local nyc_legislation data directory
+func (n *NYC) WithSearchIndex(dir string) *NYC {
+	n.index = &searchIndex{dir: dir, sessions: make(map[legislature.Session]indexedSession)}
+	return n
+}
+
+// Search finds legislation with a File, Name or Title matching all the words in query
+func (n NYC) Search(ctx context.Context, query string, session legislature.Session) ([]legislature.Legislation, error) {
+	if n.index == nil {
+		return nil, nil
+	}
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return nil, nil
+	}
+	if session.StartYear == 0 {
+		session = n.calendar.Current()
+	}
+	entries, err := n.index.load(n, session)
+	if err != nil {
+		return nil, err
+	}
+	var out []legislature.Legislation
+	for _, e := range entries {
+		if matchesAll(e.text, words) {
+			out = append(out, *e.legislation)
+		}
+		if len(out) == searchLimit {
+			break
+		}
+	}
+	return out, nil
+}
+
+func matchesAll(text string, words []string) bool {
+	for _, w := range words {
+		if !strings.Contains(text, w) {
+			return false
+		}
+	}
+	return true
+}
+
+// load returns the index for a session (most recently introduced first)
+func (s *searchIndex) load(n NYC, session legislature.Session) ([]indexEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i, ok := s.sessions[session]; ok && time.Since(i.loaded) < indexTTL {
+		return i.entries, nil
+	}
+	var entries []indexEntry
+	for year := session.StartYear; year <= session.EndYear; year++ {
+		for _, dir := range []string{"introduction", "resolution"} {
+			files, err := filepath.Glob(filepath.Join(s.dir, dir, fmt.Sprintf("%d", year), "*.json"))
+			if err != nil {
+				return nil, err
+			}
+			for _, fn := range files {
+				e, err := readIndexEntry(n, fn)
+				if err != nil {
+					log.WithField("file", fn).Warnf("skipping %s", err)
+					continue
+				}
+				if e != nil {
+					entries = append(entries, *e)
+				}
+			}
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].legislation.IntroducedDate.After(entries[j].legislation.IntroducedDate)
+	})
+	log.Infof("indexed %d NYC legislation for %d-%d", len(entries), session.StartYear, session.EndYear)
+	s.sessions[session] = indexedSession{loaded: time.Now(), entries: entries}
+	return entries, nil
+}
+
+func readIndexEntry(n NYC, fn string) (*indexEntry, error) {
+	body, err := os.ReadFile(fn)
+	if err != nil {
+		return nil, err
+	}
+	var d db.Legislation
+	if err := json.Unmarshal(body, &d); err != nil {
+		return nil, err
+	}
+	l := n.NewLegislation(&d)
+	if l == nil {
+		return nil, nil
+	}
+	return &indexEntry{
+		text:        strings.ToLower(strings.Join([]string{d.File, d.Name, d.Title, string(l.ID)}, " ")),
+		legislation: l,
+	}, nil
+}
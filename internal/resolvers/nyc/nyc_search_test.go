@@ -0,0 +1,51 @@
+package nyc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+func TestSearch(t *testing.T) {
+	n := New(legislature.Body{ID: "nyc"}).WithSearchIndex("testdata")
+	session := DefaultCalendar.Find(2024)
+	type testCase struct {
+		query    string
+		expected []legislature.LegislationID
+	}
+	tests := []testCase{
+		{"e-bike", []legislature.LegislationID{"res-0012-2024", "0001-2024"}},
+		{"E-Bike battery training", []legislature.LegislationID{"0001-2024"}},
+		{"0102-2024", []legislature.LegislationID{"0102-2024"}},
+		{"res 0012", []legislature.LegislationID{"res-0012-2024"}},
+		{"zoning", nil},
+		{" ", nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.query, func(t *testing.T) {
+			got, err := n.Search(context.Background(), tc.query, session)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []legislature.LegislationID
+			for _, l := range got {
+				ids = append(ids, l.ID)
+			}
+			if len(ids) != len(tc.expected) {
+				t.Fatalf("got %v expected %v", ids, tc.expected)
+			}
+			for i := range ids {
+				if ids[i] != tc.expected[i] {
+					t.Errorf("got %v expected %v", ids, tc.expected)
+				}
+			}
+		})
+	}
+
+	// without an index there are no results
+	got, err := New(legislature.Body{ID: "nyc"}).Search(context.Background(), "e-bike", session)
+	if err != nil || got != nil {
+		t.Errorf("got %v %v expected no results", got, err)
+	}
+}
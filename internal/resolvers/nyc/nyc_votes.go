@@ -0,0 +1,53 @@
+package nyc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+	"github.com/jehiah/legislator/db"
+)
+
+// Votes returns the committee and stated meeting votes from intro.nyc
+func (n NYC) Votes(ctx context.Context, id legislature.LegislationID) ([]legislature.RollCall, error) {
+	raw, err := n.Raw(ctx, &legislature.Legislation{ID: id})
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, legislature.ErrNotFound
+	}
+	return rollCalls(raw), nil
+}
+
+func rollCalls(d *db.Legislation) []legislature.RollCall {
+	var out []legislature.RollCall
+	for _, h := range d.History {
+		if len(h.Votes) == 0 {
+			continue
+		}
+		r := legislature.RollCall{
+			Date:   h.Date,
+			Motion: h.Action,
+			Result: h.PassedFlagName,
+		}
+		if committee, ok := strings.CutPrefix(h.BodyName, "Committee on "); ok {
+			r.Committee = committee
+		}
+		for _, v := range h.Votes {
+			r.Votes = append(r.Votes, legislature.MemberVote{
+				Member: legislature.Member{
+					NumericID: v.ID,
+					FullName:  strings.TrimSpace(v.FullName),
+					Slug:      v.Slug,
+					URL:       "https://intro.nyc/councilmembers/" + v.Slug,
+				},
+				Position: legislature.NormalizeVotePosition(v.Vote),
+			})
+		}
+		r.Tally = legislature.TallyVotes(r.Votes)
+		out = append(out, r)
+	}
+	legislature.SortRollCalls(out)
+	return out
+}
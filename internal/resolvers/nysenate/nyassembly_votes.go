@@ -101,6 +101,7 @@ func parseAssemblyVotes(r io.Reader, members []legislature.Member) ([]BillVote,
 				dateNext = false
 				committeeNext = false
 				caption = ""
+				commitee = ""
 				text = ""
 			case "td":
 				text = ""
@@ -189,10 +190,7 @@ func parseAssemblyVotes(r io.Reader, members []legislature.Member) ([]BillVote,
 				tokens = nil
 			case "caption":
 				inCaption = false
-			case "html":
-				break
 			}
 		}
 	}
-	return out, nil
 }
@@ -7,7 +7,7 @@ import (
 
 func TestAssemblyVotes(t *testing.T) {
 	ctx := context.Background()
-	m, err := api.GetMembers(ctx, Sessions.Find(2021), assemblyChamber)
+	m, err := api.GetMembers(ctx, DefaultCalendar.Find(2021), assemblyChamber)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -40,7 +40,7 @@ func TestAssemblyVotes(t *testing.T) {
 	}
 
 	// this bill has "Held for consideration" votes that should be skipped
-	m, err = api.GetMembers(ctx, Sessions.Find(2025), assemblyChamber)
+	m, err = api.GetMembers(ctx, DefaultCalendar.Find(2025), assemblyChamber)
 	if err != nil {
 		t.Fatalf("%s", err)
 	}
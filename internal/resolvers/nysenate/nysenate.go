@@ -13,17 +13,11 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-var Sessions = legislature.Sessions{
-	{StartYear: 2025, EndYear: 2026},
-	{StartYear: 2023, EndYear: 2024},
-	{StartYear: 2021, EndYear: 2022},
-	{StartYear: 2019, EndYear: 2020},
-	{StartYear: 2017, EndYear: 2018},
-	{StartYear: 2015, EndYear: 2016},
-	{StartYear: 2013, EndYear: 2014},
-	{StartYear: 2011, EndYear: 2012},
-	{StartYear: 2009, EndYear: 2010},
-	{StartYear: 2007, EndYear: 2008},
+// DefaultCalendar is the two year legislative session calendar (shared by the Senate and Assembly)
+// used when a body does not have a Calendar
+var DefaultCalendar = legislature.Calendar{
+	FirstYear: 2007,
+	Lengths:   []int{2},
 }
 
 type chamber string
@@ -32,33 +26,45 @@ const senateChamber chamber = "senate"
 const assemblyChamber chamber = "assembly"
 
 type NYSenate struct {
-	body legislature.Body
-	api  *NYSenateAPI
+	body     legislature.Body
+	calendar legislature.Calendar
+	api      *NYSenateAPI
 }
 
 func (n NYSenate) Body() legislature.Body { return n.body }
 
 func NewNYSenate(body legislature.Body, token string) *NYSenate {
 	return &NYSenate{
-		body: body,
-		api:  NewAPI(token),
+		body:     body,
+		calendar: bodyCalendar(body),
+		api:      NewAPI(token),
 	}
 }
 
 type NYAssembly struct {
-	body legislature.Body
-	api  *NYSenateAPI
+	body     legislature.Body
+	calendar legislature.Calendar
+	api      *NYSenateAPI
 }
 
 func (n NYAssembly) Body() legislature.Body { return n.body }
 
 func NewNYAssembly(body legislature.Body, token string) *NYAssembly {
 	return &NYAssembly{
-		body: body,
-		api:  NewAPI(token),
+		body:     body,
+		calendar: bodyCalendar(body),
+		api:      NewAPI(token),
 	}
 }
 
+// bodyCalendar is the body's Calendar or DefaultCalendar
+func bodyCalendar(body legislature.Body) legislature.Calendar {
+	if body.Calendar != nil {
+		return *body.Calendar
+	}
+	return DefaultCalendar
+}
+
 // LegislationSort is a custom sort function for NYSenate legislation
 // sorting upper chamber bills first, then lower chamber
 func LegislationSort(a, b *legislature.Legislation) bool {
@@ -111,7 +117,7 @@ func (a NYSenate) Lookup(ctx context.Context, u *url.URL) (*legislature.Legislat
 	if err != nil {
 		return nil, err
 	}
-	return bill.Legislation(a.body.ID), nil
+	return bill.Legislation(a.body.ID, a.calendar), nil
 }
 
 func (a NYAssembly) SupportedDomains() []string {
@@ -134,9 +140,6 @@ func (a NYAssembly) Lookup(ctx context.Context, u *url.URL) (*legislature.Legisl
 			return nil, nil
 		}
 		session, printNo = u.Query().Get("term"), u.Query().Get("bn")
-	// TODO:
-	// https://legiscan.com/NY/bill/S0{number}/{year}
-	// i.e. https://legiscan.com/NY/bill/S01046/2021.json
 	default:
 		return nil, nil
 	}
@@ -145,9 +148,9 @@ func (a NYAssembly) Lookup(ctx context.Context, u *url.URL) (*legislature.Legisl
 		return nil, err
 	}
 	if strings.HasPrefix(printNo, "S") {
-		return bill.Legislation(a.body.Bicameral), nil
+		return bill.Legislation(a.body.Bicameral, a.calendar), nil
 	}
-	return bill.Legislation(a.body.ID), nil
+	return bill.Legislation(a.body.ID, a.calendar), nil
 }
 
 func (a NYSenate) Refresh(ctx context.Context, billID legislature.LegislationID) (*legislature.Legislation, error) {
@@ -159,7 +162,7 @@ func (a NYSenate) Refresh(ctx context.Context, billID legislature.LegislationID)
 	if err != nil {
 		return nil, err
 	}
-	return bill.Legislation(a.body.ID), nil
+	return bill.Legislation(a.body.ID, a.calendar), nil
 }
 
 func (a NYAssembly) Refresh(ctx context.Context, billID legislature.LegislationID) (*legislature.Legislation, error) {
@@ -171,15 +174,15 @@ func (a NYAssembly) Refresh(ctx context.Context, billID legislature.LegislationI
 	if err != nil {
 		return nil, err
 	}
-	return bill.Legislation(a.body.ID), nil
+	return bill.Legislation(a.body.ID, a.calendar), nil
 }
 
-func (bill *Bill) Legislation(body legislature.BodyID) *legislature.Legislation {
+func (bill *Bill) Legislation(body legislature.BodyID, cal legislature.Calendar) *legislature.Legislation {
 	if bill == nil {
 		return nil
 	}
 	t, _ := time.Parse("2006-01-02T15:04:05", bill.PublishedDateTime)
-	session := Sessions.Find(bill.Session)
+	session := cal.Find(bill.Session)
 	if session == (legislature.Session{}) {
 		log.Errorf("unable to find session %v", bill.Session)
 		return nil
@@ -191,10 +194,12 @@ func (bill *Bill) Legislation(body legislature.BodyID) *legislature.Legislation
 	var sponsors []legislature.Member
 	for _, m := range bill.GetSponsors() {
 		sponsors = append(sponsors, legislature.Member{
-			NumericID: m.MemberID,
-			FullName:  m.FullName,
-			ShortName: m.ShortName,
-			URL:       memberURL(m.FullName, chamber),
+			NumericID:   m.MemberID,
+			FullName:    m.FullName,
+			ShortName:   m.ShortName,
+			URL:         memberURL(m.FullName, chamber),
+			Role:        m.Role,
+			SponsorDate: m.Date,
 			// District:  fmt.Sprintf("%d", mmm.DistrictCode),
 			// URL: fmt.Sprintf("https://www.nysenate.gov/senators/%d", m.MemberID)
 		})
@@ -213,6 +218,8 @@ func (bill *Bill) Legislation(body legislature.BodyID) *legislature.Legislation
 		Summary:        bill.Summary,
 		Type:           legType,
 		Status:         bill.Status.StatusDesc,
+		Actions:        bill.GetActions(),
+		Amendments:     bill.GetAmendments(),
 		IntroducedDate: t,
 		Session:        session,
 		SameAs:         bill.GetSameAs(),
@@ -240,9 +247,6 @@ func (a NYAssembly) DisplayID(l legislature.LegislationID) string { return a.api
 func (a NYSenate) DisplayID(l legislature.LegislationID) string   { return a.api.DisplayID(l) }
 
 func NewAPI(token string) *NYSenateAPI {
-	if token == "" {
-		panic("missing token")
-	}
 	return &NYSenateAPI{
 		token: token,
 	}
@@ -0,0 +1,58 @@
+package nysenate
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+// versions returns the amendment versions in order ("", "A", "B", ...)
+func (b Bill) versions() []string {
+	var versions []string
+	for v := range b.Amendments.Items {
+		versions = append(versions, v)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// GetAmendments returns each version of the bill in order
+func (b Bill) GetAmendments() []legislature.Amendment {
+	var o []legislature.Amendment
+	for _, v := range b.versions() {
+		a := b.Amendments.Items[v]
+		published, _ := time.Parse("2006-01-02", a.PublishDate)
+		o = append(o, legislature.Amendment{
+			Version:     v,
+			PrintNo:     a.PrintNo,
+			PublishDate: published,
+			LawSection:  a.LawSection,
+			Text:        normalizeBillText(a.FullText),
+		})
+	}
+	return o
+}
+
+var (
+	lineNumber  = regexp.MustCompile(`^\s*\d+\s+`)
+	pageHeader  = regexp.MustCompile(`^\s*[SA]\. \d+(--[A-Z])?\s+\d+\s*$`) // i.e. "S. 1234--A    2"
+	sentenceEnd = regexp.MustCompile(`([.;:])\s+([A-Z§(])`)
+)
+
+// normalizeBillText removes line numbers and page headers and re-flows the text
+// with one sentence per line so that a diff between versions shows the changed
+// text and not changes in line wrapping
+func normalizeBillText(s string) string {
+	var words []string
+	for _, line := range strings.Split(s, "\n") {
+		if pageHeader.MatchString(line) {
+			continue
+		}
+		line = lineNumber.ReplaceAllString(line, "")
+		words = append(words, strings.Fields(line)...)
+	}
+	return sentenceEnd.ReplaceAllString(strings.Join(words, " "), "$1\n$2")
+}
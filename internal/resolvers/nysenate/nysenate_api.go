@@ -3,10 +3,12 @@ package nysenate
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/jehiah/legislation.support/internal/legislature"
 	log "github.com/sirupsen/logrus"
@@ -30,6 +32,9 @@ func (a NYSenateAPI) GetBill(ctx context.Context, session, printNo string) (*Bil
 }
 
 func (a NYSenateAPI) get(ctx context.Context, path string, params *url.Values, v interface{}) error {
+	if a.token == "" {
+		return errors.New("missing NY Senate API token")
+	}
 	if params == nil {
 		params = &url.Values{}
 	}
@@ -82,46 +87,87 @@ func (b Bill) GetSubstitutedBy() legislature.LegislationID {
 	return ""
 }
 
-func (b Bill) GetSponsors() []MemberEntry {
-	o := []MemberEntry{
-		b.Sponsor.Member,
+// Sponsor is a MemberEntry with their role on a bill
+type Sponsor struct {
+	MemberEntry
+	Role legislature.SponsorRole
+	Date time.Time // the publish date of the amendment they were first listed on (if known)
+}
+
+// GetSponsors returns the prime sponsor followed by cosponsors and multi-sponsors
+//
+// Cosponsors first listed on a later amendment are dated by that amendments
+// publish date; those on the original version are not dated as they may have
+// been added at any time
+func (b Bill) GetSponsors() []Sponsor {
+	var introduced time.Time
+	if a, ok := b.Amendments.Items[""]; ok {
+		introduced, _ = time.Parse("2006-01-02", a.PublishDate)
+	}
+
+	o := []Sponsor{
+		{MemberEntry: b.Sponsor.Member, Role: legislature.PrimeSponsor, Date: introduced},
 	}
 	seen := make(map[int]bool)
-	// TODO: look at current ammendment only
-	for _, a := range b.Amendments.Items {
+	seen[b.Sponsor.Member.MemberID] = true
+	add := func(m MemberEntry, role legislature.SponsorRole, date time.Time) {
+		if seen[m.MemberID] {
+			return
+		}
+		seen[m.MemberID] = true
+		o = append(o, Sponsor{MemberEntry: m, Role: role, Date: date})
+	}
+	for _, v := range b.versions() {
+		a := b.Amendments.Items[v]
+		var date time.Time
+		if v != "" {
+			date, _ = time.Parse("2006-01-02", a.PublishDate)
+		}
 		for _, m := range a.CoSponsors.Items {
-			if seen[m.MemberID] {
-				continue
-			}
-			seen[m.MemberID] = true
-			o = append(o, m)
+			add(m, legislature.Cosponsor, date)
 		}
-		// TODO MultiSponsors
-		if a.MultiSponsors.Size > 0 {
-			// log.Printf("MultiSponsors %d-%s %#v", b.Session, b.BasePrintNo, a.MultiSponsors)
-			for _, m := range a.MultiSponsors.Items {
-				if seen[m.MemberID] {
-					continue
-				}
-				seen[m.MemberID] = true
-				o = append(o, m)
-			}
+		for _, m := range a.MultiSponsors.Items {
+			add(m, legislature.MultiSponsor, date)
 		}
 	}
-	// TODO AdditionalSponsors
-	if b.AdditionalSponsors.Size > 0 {
-		// log.Printf("AdditionalSponsors %d-%s %#v", b.Session, b.BasePrintNo, b.AdditionalSponsors)
-		for _, m := range b.AdditionalSponsors.Items {
-			if seen[m.MemberID] {
-				continue
+	for _, m := range b.AdditionalSponsors.Items {
+		add(m, legislature.Cosponsor, time.Time{})
+	}
+	return o
+}
+
+// GetActions returns the normalized bill history
+//
+// i.e. "REFERRED TO HEALTH", "PASSED SENATE", "SIGNED CHAP.123"
+func (b Bill) GetActions() []legislature.Action {
+	var o []legislature.Action
+	for _, a := range b.Actions.Items {
+		date, _ := time.Parse("2006-01-02", a.Date)
+		action := legislature.Action{
+			Date:    date,
+			Chamber: chamberName(a.Chamber),
+			Text:    a.Text,
+			Kind:    legislature.ActionKindFromText(a.Text),
+		}
+		for _, prefix := range []string{"REFERRED TO ", "COMMITTED TO ", "AMEND AND RECOMMIT TO ", "AMEND (T) AND RECOMMIT TO "} {
+			if committee, ok := strings.CutPrefix(strings.ToUpper(a.Text), prefix); ok {
+				action.Committee = committee
+				break
 			}
-			seen[m.MemberID] = true
-			o = append(o, m)
 		}
+		o = append(o, action)
 	}
 	return o
 }
 
+// chamberName formats SENATE as Senate
+func chamberName(c string) string {
+	if c == "" {
+		return ""
+	}
+	return strings.ToUpper(c[:1]) + strings.ToLower(c[1:])
+}
+
 type VoteEntry struct {
 	MemberID  int
 	Chamber   string
@@ -223,7 +269,7 @@ type Bill struct {
 	SubstitutedBy     struct {
 		BasePrintNo    string `json:"basePrintNo"`
 		Session        int    `json:"session"`
-		BasePrintNoStr string `json:"basePrintNoStr`
+		BasePrintNoStr string `json:"basePrintNoStr"`
 	} `json:"substitutedBy"`
 	Sponsor struct {
 		Member MemberEntry `json:"member"`
@@ -254,36 +300,8 @@ type Bill struct {
 		SequenceNo int    `json:"sequenceNo"`
 	} `json:"programInfo"`
 	Amendments struct {
-		Items map[string]struct {
-			BasePrintNo    string `json:"basePrintNo"`
-			Session        int    `json:"session"`
-			BasePrintNoStr string `json:"basePrintNoStr"`
-			PrintNo        string `json:"printNo"`
-			Version        string `json:"version"`
-			PublishDate    string `json:"publishDate"`
-			SameAs         struct {
-				Items []struct {
-					BasePrintNo string `json:"basePrintNo"`
-					Session     int    `json:"session"`
-					PrintNo     string `json:"printNo"`
-					Version     string `json:"version"`
-				} `json:"items"`
-				Size int `json:"size"`
-			} `json:"sameAs"`
-			Memo             string          `json:"memo"`
-			LawSection       string          `json:"lawSection"`
-			LawCode          string          `json:"lawCode"`
-			ActClause        string          `json:"actClause"`
-			FullTextFormats  []string        `json:"fullTextFormats"`
-			FullText         string          `json:"fullText"`
-			FullTextHTML     interface{}     `json:"fullTextHtml"`
-			FullTextTemplate interface{}     `json:"fullTextTemplate"`
-			CoSponsors       MemberEntryList `json:"coSponsors"`
-			MultiSponsors    MemberEntryList `json:"multiSponsors"`
-			UniBill          bool            `json:"uniBill"`
-			Stricken         bool            `json:"stricken"`
-		} `json:"items"`
-		Size int `json:"size"`
+		Items map[string]BillAmendment `json:"items"`
+		Size  int                      `json:"size"`
 	} `json:"amendments"`
 	Votes struct {
 		Items []BillVote `json:"items"`
@@ -383,6 +401,37 @@ type Bill struct {
 	} `json:"billInfoRefs"`
 }
 
+// BillAmendment is a version of a bill (i.e. the original print or an "A" amendment)
+type BillAmendment struct {
+	BasePrintNo    string `json:"basePrintNo"`
+	Session        int    `json:"session"`
+	BasePrintNoStr string `json:"basePrintNoStr"`
+	PrintNo        string `json:"printNo"`
+	Version        string `json:"version"`
+	PublishDate    string `json:"publishDate"`
+	SameAs         struct {
+		Items []struct {
+			BasePrintNo string `json:"basePrintNo"`
+			Session     int    `json:"session"`
+			PrintNo     string `json:"printNo"`
+			Version     string `json:"version"`
+		} `json:"items"`
+		Size int `json:"size"`
+	} `json:"sameAs"`
+	Memo             string          `json:"memo"`
+	LawSection       string          `json:"lawSection"`
+	LawCode          string          `json:"lawCode"`
+	ActClause        string          `json:"actClause"`
+	FullTextFormats  []string        `json:"fullTextFormats"`
+	FullText         string          `json:"fullText"`
+	FullTextHTML     interface{}     `json:"fullTextHtml"`
+	FullTextTemplate interface{}     `json:"fullTextTemplate"`
+	CoSponsors       MemberEntryList `json:"coSponsors"`
+	MultiSponsors    MemberEntryList `json:"multiSponsors"`
+	UniBill          bool            `json:"uniBill"`
+	Stricken         bool            `json:"stricken"`
+}
+
 type BillVote struct {
 	Version   string `json:"version"`
 	VoteType  string `json:"voteType"`
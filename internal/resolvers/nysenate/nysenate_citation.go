@@ -0,0 +1,52 @@
+package nysenate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+// i.e. S1234, S.1234, S 1234 or S1234A (but not "S. 1234" which is the congress.gov style)
+var citationPattern = regexp.MustCompile(`(?i)^([SA])(?:\.|\s)?([0-9]{1,5})([A-Z])?$`)
+
+// parseCitation parses a print number citation for a chamber ("S" or "A"). A citation
+// may include the session as a LegislationID does (i.e. "2023-S1234")
+func parseCitation(cal legislature.Calendar, prefix, citation string, session legislature.Session) (legislature.LegislationID, bool) {
+	citation = strings.TrimSpace(citation)
+	if s, printNo := splitLegislationID(legislature.LegislationID(citation)); printNo != "" {
+		year, err := strconv.Atoi(s)
+		if err != nil {
+			return "", false
+		}
+		session, citation = cal.Find(year), printNo
+		if session.StartYear == 0 {
+			return "", false
+		}
+	}
+	p := citationPattern.FindStringSubmatch(citation)
+	if p == nil || !strings.EqualFold(p[1], prefix) {
+		return "", false
+	}
+	if session.StartYear == 0 {
+		session = cal.Current()
+	}
+	n, _ := strconv.Atoi(p[2])
+	if n == 0 {
+		return "", false
+	}
+	// the amendment letter is dropped; bookmarks are for the base print number
+	return legislature.LegislationID(fmt.Sprintf("%d-%s%d", session.StartYear, prefix, n)), true
+}
+
+// ParseCitation parses a Senate print number (i.e. "S1234" or "2023-S1234A")
+func (a NYSenate) ParseCitation(citation string, session legislature.Session) (legislature.LegislationID, bool) {
+	return parseCitation(a.calendar, "S", citation, session)
+}
+
+// ParseCitation parses an Assembly print number (i.e. "A.4567")
+func (a NYAssembly) ParseCitation(citation string, session legislature.Session) (legislature.LegislationID, bool) {
+	return parseCitation(a.calendar, "A", citation, session)
+}
@@ -40,8 +40,9 @@ func (a NYSenateAPI) Scorecard(ctx context.Context, body legislature.Body, bookm
 	default:
 		return nil, fmt.Errorf("invalid chamber %s", body.ID)
 	}
+	cal := bodyCalendar(body)
 
-	people, err := a.GetMembers(ctx, Sessions.Current(), c)
+	people, err := a.GetMembers(ctx, cal.Current(), c)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +92,7 @@ func (a NYSenateAPI) Scorecard(ctx context.Context, body legislature.Body, bookm
 
 			var otherBillData *Bill
 			if otherBill != "" {
-				sb.Legislation = billData.Legislation(body.ID)
+				sb.Legislation = billData.Legislation(body.ID, cal)
 				otherBillSession, otherBasePrintNo := splitLegislationID(otherBill)
 				otherBillData, err = a.GetBill(ctx, otherBillSession, otherBasePrintNo)
 				if err != nil {
@@ -104,37 +105,38 @@ func (a NYSenateAPI) Scorecard(ctx context.Context, body legislature.Body, bookm
 				}
 			}
 
-			// Assembly votes are not exposed in the NY Senate API
-			// https://github.com/nysenate/OpenLegislation/issues/122
-			if c == assemblyChamber {
-				extraVotes, err := a.AssemblyVotes(ctx, people, billSession, basePrintNo)
-				if err != nil {
-					return err
-				}
-				for _, vi := range extraVotes.Votes.Items {
-					if vi.VoteType == "Held for Consideration" {
-						// https://nyassembly.gov/leg/?default_fld=&leg_video=&bn=A06141&term=&Summary=Y&Actions=Y&Committee%26nbspVotes=Y&Floor%26nbspVotes=Y&Text=Y
-						// Some bills are "Held for Consideration" which doesn't represent a vote for the bill - it's a vote to hold a bill
-						continue
-					}
-					billData.Votes.Items = append(billData.Votes.Items, vi)
-				}
+			rollCalls, err := a.rollCalls(ctx, billData, people)
+			if err != nil {
+				return err
 			}
 
 			scores := make(map[int]string)
 			remaining := make(map[int]bool)
 			for _, sponsor := range billData.GetSponsors() {
 				scores[sponsor.MemberID] = "Sponsor"
+				if sponsor.Role == legislature.PrimeSponsor {
+					scores[sponsor.MemberID] = string(legislature.PrimeSponsor)
+				}
 				remaining[sponsor.MemberID] = true
 			}
 
-			for _, v := range billData.GetVotes().Filter(billData.BillType.Chamber) {
-				if v.MemberID == 0 {
-					log.WithField("session", billSession).WithField("bill", basePrintNo).Warnf("unexpected memberID=0 %#v", v)
+			for _, r := range rollCalls {
+				if r.Chamber != chamberName(billData.BillType.Chamber) {
+					continue
+				}
+				if r.Motion == "Held for Consideration" {
+					// https://nyassembly.gov/leg/?default_fld=&leg_video=&bn=A06141&term=&Summary=Y&Actions=Y&Committee%26nbspVotes=Y&Floor%26nbspVotes=Y&Text=Y
+					// Some bills are "Held for Consideration" which doesn't represent a vote for the bill - it's a vote to hold a bill
 					continue
 				}
-				scores[v.MemberID] = v.Vote
-				remaining[v.MemberID] = true
+				for _, v := range r.Votes {
+					if v.Member.NumericID == 0 {
+						log.WithField("session", billSession).WithField("bill", basePrintNo).Warnf("unexpected memberID=0 %#v", v)
+						continue
+					}
+					scores[v.Member.NumericID] = string(v.Position)
+					remaining[v.Member.NumericID] = true
+				}
 			}
 
 			seenPeople := make(map[int]bool)
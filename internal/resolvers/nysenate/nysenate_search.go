@@ -0,0 +1,89 @@
+package nysenate
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+type BillSearchResponse struct {
+	Success      bool   `json:"success"`
+	Message      string `json:"message"`
+	ResponseType string `json:"responseType"` // "search-results list"
+	Result       struct {
+		Items []struct {
+			Result Bill    `json:"result"`
+			Rank   float64 `json:"rank"`
+		} `json:"items"`
+	} `json:"result"`
+	Total int `json:"total"`
+}
+
+// SearchBills runs an OpenLegislation bill search for a session
+//
+// https://legislation.nysenate.gov/static/docs/html/bills.html#search-for-bills
+func (a NYSenateAPI) SearchBills(ctx context.Context, session legislature.Session, c chamber, query string, limit int) ([]Bill, error) {
+	if session.StartYear == 0 || strings.TrimSpace(query) == "" {
+		return nil, nil
+	}
+	path := fmt.Sprintf("/api/3/bills/%d/search", session.StartYear)
+	params := &url.Values{
+		"term":  []string{searchTerm(c, query)},
+		"limit": []string{fmt.Sprintf("%d", limit)},
+	}
+	var data BillSearchResponse
+	err := a.get(ctx, path, params, &data)
+	if err != nil {
+		return nil, err
+	}
+	var out []Bill
+	for _, i := range data.Result.Items {
+		out = append(out, i.Result)
+	}
+	return out, nil
+}
+
+// searchTerm limits a search to a chamber. Query syntax characters are escaped so that
+// the query is treated as keywords (i.e. "S1234" or "e-bike")
+func searchTerm(c chamber, query string) string {
+	var b strings.Builder
+	for _, r := range query {
+		if strings.ContainsRune(`+-=&|><!(){}[]^"~*?:\/`, r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return fmt.Sprintf("billType.chamber:%s AND (%s)", strings.ToUpper(string(c)), b.String())
+}
+
+const searchLimit = 20
+
+// Search finds Senate bills matching a keyword query (or print number)
+func (a NYSenate) Search(ctx context.Context, query string, session legislature.Session) ([]legislature.Legislation, error) {
+	return search(ctx, a.api, a.body.ID, a.calendar, senateChamber, query, session)
+}
+
+// Search finds Assembly bills matching a keyword query (or print number)
+func (a NYAssembly) Search(ctx context.Context, query string, session legislature.Session) ([]legislature.Legislation, error) {
+	return search(ctx, a.api, a.body.ID, a.calendar, assemblyChamber, query, session)
+}
+
+func search(ctx context.Context, api *NYSenateAPI, body legislature.BodyID, cal legislature.Calendar, c chamber, query string, session legislature.Session) ([]legislature.Legislation, error) {
+	if session.StartYear == 0 {
+		session = cal.Current()
+	}
+	bills, err := api.SearchBills(ctx, session, c, query, searchLimit)
+	if err != nil {
+		return nil, err
+	}
+	var out []legislature.Legislation
+	for _, b := range bills {
+		if l := b.Legislation(body, cal); l != nil {
+			out = append(out, *l)
+		}
+	}
+	return out, nil
+}
@@ -26,7 +26,7 @@ func TestGetBill(t *testing.T) {
 }
 
 func TestGetMembers(t *testing.T) {
-	m, err := api.GetMembers(context.Background(), Sessions[0], "senate")
+	m, err := api.GetMembers(context.Background(), DefaultCalendar.Current(), "senate")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -106,3 +106,36 @@ func TestNYAssemblyLookup(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeBillText(t *testing.T) {
+	in := `                           S T A T E   O F   N E W   Y O R K
+   1    Section  1.  The  public health law is amended by adding a new
+   2  section 2500-i to read as follows:
+   3    § 2. This act shall take effect immediately.
+S. 1234--A                          2
+   1    § 3. Severability.`
+	expected := "S T A T E O F N E W Y O R K Section 1.\nThe public health law is amended by adding a new section 2500-i to read as follows:\n§ 2.\nThis act shall take effect immediately.\n§ 3.\nSeverability."
+	if got := normalizeBillText(in); got != expected {
+		t.Errorf("got %q expected %q", got, expected)
+	}
+}
+
+func TestSearchTerm(t *testing.T) {
+	type testCase struct {
+		c        chamber
+		query    string
+		expected string
+	}
+	tests := []testCase{
+		{senateChamber, "e-bike", `billType.chamber:SENATE AND (e\-bike)`},
+		{assemblyChamber, "A1234", `billType.chamber:ASSEMBLY AND (A1234)`},
+		{senateChamber, `"rent" (stabilization)`, `billType.chamber:SENATE AND (\"rent\" \(stabilization\))`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.query, func(t *testing.T) {
+			if got := searchTerm(tc.c, tc.query); got != tc.expected {
+				t.Errorf("got %q expected %q", got, tc.expected)
+			}
+		})
+	}
+}
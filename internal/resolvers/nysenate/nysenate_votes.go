@@ -0,0 +1,104 @@
+package nysenate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+func (a NYSenate) Votes(ctx context.Context, id legislature.LegislationID) ([]legislature.RollCall, error) {
+	return a.api.Votes(ctx, id, senateChamber, a.calendar)
+}
+func (a NYAssembly) Votes(ctx context.Context, id legislature.LegislationID) ([]legislature.RollCall, error) {
+	return a.api.Votes(ctx, id, assemblyChamber, a.calendar)
+}
+
+// Votes returns the committee and floor votes on the active version of a bill
+func (a NYSenateAPI) Votes(ctx context.Context, id legislature.LegislationID, c chamber, cal legislature.Calendar) ([]legislature.RollCall, error) {
+	session, printNo := splitLegislationID(id)
+	bill, err := a.GetBill(ctx, session, printNo)
+	if err != nil {
+		return nil, err
+	}
+	if bill == nil || bill.BasePrintNo == "" {
+		return nil, legislature.ErrNotFound
+	}
+	var members []legislature.Member
+	if c == assemblyChamber {
+		members, err = a.GetMembers(ctx, cal.Find(bill.Session), c)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return a.rollCalls(ctx, bill, members)
+}
+
+// rollCalls combines the votes from the NY Senate API with Assembly votes which are
+// not exposed in the NY Senate API https://github.com/nysenate/OpenLegislation/issues/122
+//
+// members are used to match Assembly votes to a MemberID
+func (a NYSenateAPI) rollCalls(ctx context.Context, bill *Bill, members []legislature.Member) ([]legislature.RollCall, error) {
+	var out []legislature.RollCall
+	for _, v := range bill.Votes.Items {
+		if v.Version != bill.ActiveVersion && v.Version != "" {
+			continue
+		}
+		out = append(out, v.RollCall())
+	}
+	if strings.EqualFold(bill.BillType.Chamber, string(assemblyChamber)) {
+		extra, err := a.AssemblyVotes(ctx, members, fmt.Sprintf("%d", bill.Session), bill.BasePrintNo)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range extra.Votes.Items {
+			out = append(out, v.RollCall())
+		}
+	}
+	legislature.SortRollCalls(out)
+	return out, nil
+}
+
+// RollCall converts a vote from the NY Senate API (or scraped from nyassembly.gov)
+func (v BillVote) RollCall() legislature.RollCall {
+	r := legislature.RollCall{
+		Date:      parseVoteDate(v.VoteDate),
+		Chamber:   chamberName(v.Committee.Chamber),
+		Committee: v.Committee.Name,
+		Motion:    v.VoteType,
+	}
+	switch v.VoteType {
+	case "FLOOR":
+		r.Motion = "Floor Vote"
+		r.Committee = ""
+	case "COMMITTEE":
+		r.Motion = "Committee Vote"
+	}
+	for _, e := range v.GetVotes() {
+		if r.Chamber == "" {
+			r.Chamber = chamberName(e.Chamber)
+		}
+		r.Votes = append(r.Votes, legislature.MemberVote{
+			Member: legislature.Member{
+				NumericID: e.MemberID,
+				ShortName: e.ShortName,
+			},
+			Position: legislature.NormalizeVotePosition(e.Vote),
+		})
+	}
+	r.Tally = legislature.TallyVotes(r.Votes)
+	return r
+}
+
+// parseVoteDate handles dates from the NY Senate API (2023-06-07) and nyassembly.gov (06/07/2023)
+func parseVoteDate(s string) time.Time {
+	s = strings.TrimSpace(s)
+	for _, layout := range []string{"2006-01-02", "01/02/2006", "January 2, 2006", "Jan 2, 2006"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
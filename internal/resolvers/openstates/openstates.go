@@ -0,0 +1,289 @@
+// Package openstates is a resolver for state legislatures using the Open States (Plural) data model
+//
+// Data is read from a Source: either the v3 API or a local bulk data directory
+package openstates
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	upperChamber = "upper"
+	lowerChamber = "lower"
+)
+
+// OpenStates resolves legislation for one chamber of a state legislature
+type OpenStates struct {
+	body    legislature.Body
+	state   string // i.e. "nj"
+	chamber string // upper or lower
+	source  Source
+
+	once         sync.Once
+	jurisdiction *Jurisdiction
+}
+
+// NewUpper returns a resolver for the upper chamber (i.e. the Senate)
+func NewUpper(b legislature.Body, state string, source Source) *OpenStates {
+	return &OpenStates{body: b, state: strings.ToLower(state), chamber: upperChamber, source: source}
+}
+
+// NewLower returns a resolver for the lower chamber (i.e. the House or Assembly)
+func NewLower(b legislature.Body, state string, source Source) *OpenStates {
+	return &OpenStates{body: b, state: strings.ToLower(state), chamber: lowerChamber, source: source}
+}
+
+func (o *OpenStates) Body() legislature.Body { return o.body }
+
+func (o *OpenStates) SupportedDomains() []string {
+	return []string{"openstates.org"}
+}
+
+// getJurisdiction loads the legislative sessions once
+func (o *OpenStates) getJurisdiction(ctx context.Context) *Jurisdiction {
+	o.once.Do(func() {
+		j, err := o.source.Jurisdiction(ctx, o.state)
+		if err != nil {
+			log.WithField("state", o.state).Warnf("error loading jurisdiction %s", err)
+			return
+		}
+		o.jurisdiction = j
+	})
+	return o.jurisdiction
+}
+
+// LegislationID is the session and identifier without spaces i.e. "221-S1234" for "S 1234" in session "221"
+func LegislationID(session, identifier string) legislature.LegislationID {
+	return legislature.LegislationID(strings.ReplaceAll(session, "/", "_") + "-" + compactIdentifier(identifier))
+}
+
+// parseLegislationID returns the session and identifier: 2023-2024-HB123 => 2023-2024, HB 123
+func parseLegislationID(id legislature.LegislationID) (session, identifier string, err error) {
+	i := strings.LastIndex(string(id), "-")
+	if i <= 0 {
+		return "", "", fmt.Errorf("invalid Open States LegislationID %q", id)
+	}
+	return strings.ReplaceAll(string(id[:i]), "_", "/"), expandIdentifier(string(id[i+1:])), nil
+}
+
+func compactIdentifier(s string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(s), ""))
+}
+
+var identifierPattern = regexp.MustCompile(`^([A-Za-z]+)\s*([0-9].*)$`)
+
+// expandIdentifier HB123 => HB 123
+func expandIdentifier(s string) string {
+	return identifierPattern.ReplaceAllString(strings.ToUpper(s), "$1 $2")
+}
+
+func (o *OpenStates) DisplayID(id legislature.LegislationID) string {
+	_, identifier, err := parseLegislationID(id)
+	if err != nil {
+		return string(id)
+	}
+	return compactIdentifier(identifier)
+}
+
+// Link returns the openstates.org page i.e. https://openstates.org/nj/bills/221/S1234/
+func (o *OpenStates) Link(id legislature.LegislationID) *url.URL {
+	session, identifier, _ := parseLegislationID(id)
+	return &url.URL{
+		Scheme: "https",
+		Host:   "openstates.org",
+		Path:   fmt.Sprintf("/%s/bills/%s/%s/", o.state, session, compactIdentifier(identifier)),
+	}
+}
+
+// i.e. /nj/bills/221/S1234/
+var billPattern = regexp.MustCompile(`^/([a-z]{2})/bills/([^/]+)/([^/]+)/?$`)
+
+func (o *OpenStates) Lookup(ctx context.Context, u *url.URL) (*legislature.Legislation, error) {
+	switch u.Hostname() {
+	case "openstates.org", "www.openstates.org":
+	default:
+		return nil, nil
+	}
+	p := billPattern.FindStringSubmatch(u.Path)
+	if p == nil || p[1] != o.state {
+		return nil, nil
+	}
+	bill, err := o.source.Bill(ctx, o.state, p[2], expandIdentifier(p[3]))
+	if err == legislature.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if bill.FromOrganization.Classification != o.chamber {
+		// the resolver for the other chamber will match
+		return nil, nil
+	}
+	return o.NewLegislation(ctx, *bill), nil
+}
+
+func (o *OpenStates) Refresh(ctx context.Context, id legislature.LegislationID) (*legislature.Legislation, error) {
+	bill, err := o.bill(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return o.NewLegislation(ctx, *bill), nil
+}
+
+func (o *OpenStates) bill(ctx context.Context, id legislature.LegislationID) (*Bill, error) {
+	session, identifier, err := parseLegislationID(id)
+	if err != nil {
+		return nil, err
+	}
+	bill, err := o.source.Bill(ctx, o.state, session, identifier)
+	if err != nil {
+		return nil, err
+	}
+	if bill.FromOrganization.Classification != o.chamber {
+		return nil, fmt.Errorf("%s is not a %s bill", id, o.body.Name)
+	}
+	return bill, nil
+}
+
+var yearPattern = regexp.MustCompile(`(19|20)[0-9]{2}`)
+
+// session returns the years of a legislative session from the jurisdiction
+// or from the session identifier (i.e. "2023-2024") when dates are not available
+func session(j *Jurisdiction, identifier string, fallback time.Time) legislature.Session {
+	var s legislature.Session
+	if j != nil {
+		if ls := j.Session(identifier); ls != nil {
+			s.StartYear, s.EndYear = parseDate(ls.StartDate).Year(), parseDate(ls.EndDate).Year()
+		}
+	}
+	if s.StartYear <= 1 {
+		years := yearPattern.FindAllString(identifier, -1)
+		switch len(years) {
+		case 0:
+			s.StartYear = fallback.Year()
+		default:
+			s.StartYear, _ = strconv.Atoi(years[0])
+			s.EndYear, _ = strconv.Atoi(years[len(years)-1])
+		}
+	}
+	if s.EndYear < s.StartYear {
+		s.EndYear = s.StartYear
+	}
+	return s
+}
+
+func (o *OpenStates) member(p Person) legislature.Member {
+	m := legislature.Member{
+		Slug:      p.ID,
+		FullName:  strings.TrimSpace(p.Name),
+		ShortName: p.FamilyName,
+		Party:     p.Party,
+		URL:       p.OpenStatesURL,
+	}
+	if p.CurrentRole != nil {
+		m.District = p.CurrentRole.District
+	}
+	return m
+}
+
+func (o *OpenStates) NewLegislation(ctx context.Context, b Bill) *legislature.Legislation {
+	legType := legislature.BillType
+	for _, c := range b.Classification {
+		if strings.Contains(c, "resolution") {
+			legType = legislature.ResolutionType
+		}
+	}
+
+	var sponsors []legislature.Member
+	for _, s := range b.Sponsorships {
+		if s.EntityType != "person" {
+			continue
+		}
+		m := legislature.Member{FullName: strings.TrimSpace(s.Name)}
+		if s.Person != nil {
+			m = o.member(*s.Person)
+		}
+		m.Role = legislature.Cosponsor
+		if s.Primary {
+			m.Role = legislature.PrimeSponsor
+		}
+		sponsors = append(sponsors, m)
+	}
+
+	var actions []legislature.Action
+	for _, a := range b.Actions {
+		action := legislature.Action{
+			Date: parseDate(a.Date),
+			Text: a.Description,
+			Kind: legislature.ActionKindFromText(a.Description),
+		}
+		if a.Organization.Classification == "committee" {
+			action.Committee = a.Organization.Name
+		}
+		actions = append(actions, action)
+	}
+
+	id := LegislationID(b.Session, b.Identifier)
+	l := &legislature.Legislation{
+		Body:           o.body.ID,
+		ID:             id,
+		DisplayID:      compactIdentifier(b.Identifier),
+		Title:          b.Title,
+		URL:            o.Link(id).String(),
+		Status:         b.LatestActionDesc,
+		Actions:        actions,
+		Type:           legType,
+		Sponsors:       sponsors,
+		IntroducedDate: parseDate(b.FirstActionDate),
+		LastModified:   parseDate(b.UpdatedAt),
+	}
+	l.Session = session(o.getJurisdiction(ctx), b.Session, l.IntroducedDate)
+	if len(b.Abstracts) > 0 {
+		l.Summary = b.Abstracts[0].Abstract
+	}
+	if len(b.Sources) > 0 {
+		l.URL = b.Sources[0].URL
+	}
+	for _, r := range b.RelatedBills {
+		if r.RelationType != "companion" {
+			continue
+		}
+		s := r.LegislativeSession
+		if s == "" {
+			s = b.Session
+		}
+		if s == b.Session {
+			l.SameAs = LegislationID(s, r.Identifier)
+			break
+		}
+	}
+	return l
+}
+
+// Members returns the people currently serving in the chamber.
+//
+// Open States bulk data only includes current legislators so past sessions are not supported
+func (o *OpenStates) Members(ctx context.Context, s legislature.Session) ([]legislature.Member, error) {
+	people, err := o.source.People(ctx, o.state)
+	if err != nil {
+		return nil, err
+	}
+	var members []legislature.Member
+	for _, p := range people {
+		if p.Chamber() != o.chamber {
+			continue
+		}
+		members = append(members, o.member(p))
+	}
+	return members, nil
+}
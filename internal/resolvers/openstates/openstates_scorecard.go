@@ -0,0 +1,75 @@
+package openstates
+
+import (
+	"context"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+	"golang.org/x/sync/errgroup"
+)
+
+func (o *OpenStates) Scorecard(ctx context.Context, bookmarks []legislature.Scorable) (*legislature.Scorecard, error) {
+	s := &legislature.Scorecard{
+		Body: &o.body,
+		Metadata: legislature.ScorecardMetadata{
+			PersonTitle: o.body.MemberName,
+		},
+		Data: make([]legislature.ScoredBookmark, len(bookmarks)),
+	}
+
+	members, err := o.Members(ctx, legislature.Session{})
+	if err != nil {
+		return s, err
+	}
+	// Open States people have string IDs; ScorecardPerson.ID is the position in the list
+	for i, m := range members {
+		s.People = append(s.People, legislature.ScorecardPerson{
+			ID:       i + 1,
+			FullName: m.FullName,
+			Party:    m.Party,
+			URL:      m.URL,
+			District: m.District,
+		})
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(5)
+	for i, b := range bookmarks {
+		g.Go(func() error {
+			sb := b.NewScore()
+			bill, err := o.bill(ctx, sb.Legislation.ID)
+			if err != nil {
+				return err
+			}
+			sb.Status = bill.LatestActionDesc
+			for _, a := range bill.Actions {
+				if a.Organization.Classification == "committee" {
+					sb.Committee = a.Organization.Name
+				}
+			}
+
+			scores := make(map[string]string)
+			for _, sponsor := range bill.Sponsorships {
+				if sponsor.Person == nil {
+					continue
+				}
+				scores[sponsor.Person.ID] = "Sponsor"
+				if sponsor.Primary {
+					scores[sponsor.Person.ID] = string(legislature.PrimeSponsor)
+				}
+			}
+			for _, r := range o.rollCalls(*bill) {
+				for _, v := range r.Votes {
+					if v.Member.Slug != "" {
+						scores[v.Member.Slug] = string(v.Position)
+					}
+				}
+			}
+			for _, m := range members {
+				sb.Scores = append(sb.Scores, legislature.Score{Status: scores[m.Slug], Desired: !sb.Oppose})
+			}
+			s.Data[i] = sb
+			return nil
+		})
+	}
+	return s, g.Wait()
+}
@@ -0,0 +1,143 @@
+package openstates
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+	log "github.com/sirupsen/logrus"
+)
+
+// Source provides Open States data for a state (i.e. "nj")
+type Source interface {
+	Jurisdiction(ctx context.Context, state string) (*Jurisdiction, error)
+	Bill(ctx context.Context, state, session, identifier string) (*Bill, error)
+	People(ctx context.Context, state string) ([]Person, error)
+}
+
+// DirSource reads Open States bulk data from a local directory for offline deployments
+//
+//	{dir}/{state}/jurisdiction.json
+//	{dir}/{state}/people.json
+//	{dir}/{state}/bills/{session}/{identifier}.json (i.e. bills/221/S1234.json)
+type DirSource string
+
+func (d DirSource) read(v interface{}, elem ...string) error {
+	f, err := os.Open(filepath.Join(append([]string{string(d)}, elem...)...))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return legislature.ErrNotFound
+		}
+		return err
+	}
+	defer f.Close()
+	return json.NewDecoder(f).Decode(v)
+}
+
+func (d DirSource) Jurisdiction(ctx context.Context, state string) (*Jurisdiction, error) {
+	var j Jurisdiction
+	return &j, d.read(&j, state, "jurisdiction.json")
+}
+
+func (d DirSource) Bill(ctx context.Context, state, session, identifier string) (*Bill, error) {
+	var b Bill
+	err := d.read(&b, state, "bills", filepath.Base(session), compactIdentifier(identifier)+".json")
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (d DirSource) People(ctx context.Context, state string) ([]Person, error) {
+	var p []Person
+	return p, d.read(&p, state, "people.json")
+}
+
+// APISource uses the Open States v3 API https://v3.openstates.org/
+//
+// ENV OPENSTATES_API_KEY
+type APISource struct {
+	Key string
+}
+
+func (a APISource) get(ctx context.Context, path string, params url.Values, v interface{}) error {
+	if a.Key == "" {
+		return errors.New("missing Open States API key")
+	}
+	u := "https://v3.openstates.org" + path
+	if encoded := params.Encode(); encoded != "" {
+		u += "?" + encoded
+	}
+	log.WithContext(ctx).WithField("openstates_api", u).Debug("OpenStates.get")
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-API-KEY", a.Key)
+	req.Header.Set("User-Agent", "https://legislation.support/")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == 404 {
+		return legislature.ErrNotFound
+	}
+	if resp.StatusCode != 200 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("openstates api error: %s: %s", resp.Status, string(body))
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func jurisdictionID(state string) string {
+	return fmt.Sprintf("ocd-jurisdiction/country:us/state:%s/government", strings.ToLower(state))
+}
+
+func (a APISource) Jurisdiction(ctx context.Context, state string) (*Jurisdiction, error) {
+	var j Jurisdiction
+	err := a.get(ctx, "/jurisdictions/"+jurisdictionID(state), url.Values{"include": []string{"legislative_sessions"}}, &j)
+	return &j, err
+}
+
+func (a APISource) Bill(ctx context.Context, state, session, identifier string) (*Bill, error) {
+	var b Bill
+	path := fmt.Sprintf("/bills/%s/%s/%s", state, url.PathEscape(session), url.PathEscape(identifier))
+	params := url.Values{"include": []string{"sponsorships", "abstracts", "actions", "sources", "votes", "related_bills"}}
+	if err := a.get(ctx, path, params, &b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (a APISource) People(ctx context.Context, state string) ([]Person, error) {
+	var people []Person
+	for page := 1; ; page++ {
+		var resp struct {
+			Results    []Person `json:"results"`
+			Pagination struct {
+				MaxPage int `json:"max_page"`
+			} `json:"pagination"`
+		}
+		params := url.Values{
+			"jurisdiction": []string{jurisdictionID(state)},
+			"per_page":     []string{"50"},
+			"page":         []string{fmt.Sprint(page)},
+		}
+		if err := a.get(ctx, "/people", params, &resp); err != nil {
+			return nil, err
+		}
+		people = append(people, resp.Results...)
+		if page >= resp.Pagination.MaxPage {
+			return people, nil
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package openstates
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+var (
+	testSource   = DirSource("testdata")
+	testSenate   = NewUpper(legislature.Body{ID: "nj-senate", Name: "NJ Senate", Bicameral: "nj-assembly", UpperHouse: true}, "NJ", testSource)
+	testAssembly = NewLower(legislature.Body{ID: "nj-assembly", Name: "NJ Assembly", Bicameral: "nj-senate"}, "NJ", testSource)
+)
+
+func TestLegislationID(t *testing.T) {
+	type testCase struct {
+		session, identifier string
+		expected            legislature.LegislationID
+	}
+	tests := []testCase{
+		{"221", "S 1234", "221-S1234"},
+		{"2023-2024", "HB 123", "2023-2024-HB123"},
+		{"2023", "SJR 5", "2023-SJR5"},
+	}
+	for _, tc := range tests {
+		t.Run(string(tc.expected), func(t *testing.T) {
+			id := LegislationID(tc.session, tc.identifier)
+			if id != tc.expected {
+				t.Fatalf("got %q expected %q", id, tc.expected)
+			}
+			session, identifier, err := parseLegislationID(id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if session != tc.session || identifier != tc.identifier {
+				t.Errorf("parsed %q %q expected %q %q", session, identifier, tc.session, tc.identifier)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	ctx := context.Background()
+	u, _ := url.Parse("https://openstates.org/nj/bills/221/S1234/")
+
+	l, err := testAssembly.Lookup(ctx, u)
+	if err != nil || l != nil {
+		t.Fatalf("expected no match from the lower chamber got %#v %v", l, err)
+	}
+	l, err = testSenate.Lookup(ctx, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l == nil {
+		t.Fatal("expected a match")
+	}
+	if l.ID != "221-S1234" || l.DisplayID != "S1234" || l.Body != "nj-senate" {
+		t.Errorf("got ID %q DisplayID %q Body %q", l.ID, l.DisplayID, l.Body)
+	}
+	if l.SameAs != "221-A2345" {
+		t.Errorf("got SameAs %q", l.SameAs)
+	}
+	if l.Session != (legislature.Session{StartYear: 2024, EndYear: 2025}) {
+		t.Errorf("got session %v", l.Session)
+	}
+	if l.URL != "https://www.njleg.state.nj.us/bill-search/2024/S1234" {
+		t.Errorf("got URL %q", l.URL)
+	}
+	if !l.IntroducedDate.Equal(time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("got IntroducedDate %s", l.IntroducedDate)
+	}
+	if p := l.PrimeSponsor(); p == nil || p.FullName != "Jane Doe" || len(l.Sponsors) != 2 {
+		t.Errorf("got sponsors %#v", l.Sponsors)
+	}
+	if len(l.Actions) != 2 || l.Actions[1].Committee != "Transportation" {
+		t.Errorf("got actions %#v", l.Actions)
+	}
+
+	// the companion bill
+	same, err := testAssembly.Refresh(ctx, l.SameAs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if same.SameAs != l.ID || same.Body != "nj-assembly" {
+		t.Errorf("got %q SameAs %q", same.ID, same.SameAs)
+	}
+	if _, err := testSenate.Refresh(ctx, l.SameAs); err == nil {
+		t.Errorf("expected error refreshing an Assembly bill from the Senate")
+	}
+	if _, err := testSenate.Refresh(ctx, "221-S9999"); err != legislature.ErrNotFound {
+		t.Errorf("expected ErrNotFound got %v", err)
+	}
+}
+
+func TestMembers(t *testing.T) {
+	members, err := testSenate.Members(context.Background(), legislature.Session{StartYear: 2024, EndYear: 2025})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 2 {
+		t.Fatalf("got %d members expected 2", len(members))
+	}
+	if members[0].FullName != "Jane Doe" || members[0].District != "1" {
+		t.Errorf("got %#v", members[0])
+	}
+}
+
+func TestVotes(t *testing.T) {
+	votes, err := testSenate.Votes(context.Background(), "221-S1234")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(votes) != 1 {
+		t.Fatalf("got %d roll calls expected 1", len(votes))
+	}
+	r := votes[0]
+	if r.IsFloor() || r.Committee != "Transportation" || r.Tally != (legislature.VoteTally{Aye: 1, Nay: 1}) {
+		t.Errorf("got %#v", r)
+	}
+}
+
+type testScorable struct{ l *legislature.Legislation }
+
+func (s testScorable) NewScore() legislature.ScoredBookmark {
+	return legislature.ScoredBookmark{Legislation: s.l}
+}
+
+func TestScorecard(t *testing.T) {
+	s, err := testSenate.Scorecard(context.Background(), []legislature.Scorable{testScorable{&legislature.Legislation{ID: "221-S1234"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.People) != 2 || len(s.Data) != 1 {
+		t.Fatalf("got %d people %d bills", len(s.People), len(s.Data))
+	}
+	// sponsors who voted are scored by their vote
+	if got := s.Data[0].Scores[0].Status; got != "Aye" {
+		t.Errorf("got %q", got)
+	}
+	if got := s.Data[0].Scores[1].Status; got != "Nay" {
+		t.Errorf("got %q", got)
+	}
+	if s.Data[0].Committee != "Transportation" {
+		t.Errorf("got committee %q", s.Data[0].Committee)
+	}
+}
@@ -0,0 +1,155 @@
+package openstates
+
+import (
+	"strings"
+	"time"
+)
+
+// Types follow the Open States v3 data model https://v3.openstates.org/docs
+
+type Jurisdiction struct {
+	ID                  string    `json:"id"` // i.e. ocd-jurisdiction/country:us/state:nj/government
+	Name                string    `json:"name"`
+	Classification      string    `json:"classification"`
+	URL                 string    `json:"url"`
+	LegislativeSessions []Session `json:"legislative_sessions"`
+}
+
+type Session struct {
+	Identifier     string `json:"identifier"` // i.e. "221" or "2023-2024"
+	Name           string `json:"name"`
+	Classification string `json:"classification"` // primary, special
+	StartDate      string `json:"start_date"`
+	EndDate        string `json:"end_date"`
+}
+
+// Session returns the legislative session with the given identifier
+func (j Jurisdiction) Session(identifier string) *Session {
+	for _, s := range j.LegislativeSessions {
+		if s.Identifier == identifier {
+			return &s
+		}
+	}
+	return nil
+}
+
+type Organization struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Classification string `json:"classification"` // upper, lower, legislature, committee
+}
+
+type CurrentRole struct {
+	Title             string `json:"title"`
+	OrgClassification string `json:"org_classification"` // upper, lower
+	District          string `json:"district"`
+}
+
+type Person struct {
+	ID            string       `json:"id"` // i.e. ocd-person/...
+	Name          string       `json:"name"`
+	Party         string       `json:"party"`
+	GivenName     string       `json:"given_name"`
+	FamilyName    string       `json:"family_name"`
+	CurrentRole   *CurrentRole `json:"current_role"`
+	OpenStatesURL string       `json:"openstates_url"`
+}
+
+// Chamber is "upper" or "lower" (or "" if the person is not currently in office)
+func (p Person) Chamber() string {
+	if p.CurrentRole == nil {
+		return ""
+	}
+	return p.CurrentRole.OrgClassification
+}
+
+type Bill struct {
+	ID               string        `json:"id"` // i.e. ocd-bill/...
+	Session          string        `json:"session"`
+	Jurisdiction     Jurisdiction  `json:"jurisdiction"`
+	FromOrganization Organization  `json:"from_organization"`
+	Identifier       string        `json:"identifier"` // i.e. "S 1234"
+	Title            string        `json:"title"`
+	Classification   []string      `json:"classification"` // bill, resolution, ...
+	UpdatedAt        string        `json:"updated_at"`
+	OpenStatesURL    string        `json:"openstates_url"`
+	FirstActionDate  string        `json:"first_action_date"`
+	LatestActionDate string        `json:"latest_action_date"`
+	LatestActionDesc string        `json:"latest_action_description"`
+	Abstracts        []Abstract    `json:"abstracts"`
+	RelatedBills     []RelatedBill `json:"related_bills"`
+	Sponsorships     []Sponsorship `json:"sponsorships"`
+	Actions          []BillAction  `json:"actions"`
+	Sources          []Link        `json:"sources"`
+	Votes            []VoteEvent   `json:"votes"`
+}
+
+type Abstract struct {
+	Abstract string `json:"abstract"`
+	Note     string `json:"note"`
+}
+
+type RelatedBill struct {
+	Identifier         string `json:"identifier"`
+	LegislativeSession string `json:"legislative_session"`
+	RelationType       string `json:"relation_type"` // companion, prior-session, replaced-by, ...
+}
+
+type Sponsorship struct {
+	Name           string  `json:"name"`
+	EntityType     string  `json:"entity_type"` // person, organization
+	Primary        bool    `json:"primary"`
+	Classification string  `json:"classification"` // primary, cosponsor
+	Person         *Person `json:"person"`
+}
+
+type BillAction struct {
+	Organization   Organization `json:"organization"`
+	Description    string       `json:"description"`
+	Date           string       `json:"date"`
+	Classification []string     `json:"classification"`
+	Order          int          `json:"order"`
+}
+
+type Link struct {
+	URL  string `json:"url"`
+	Note string `json:"note"`
+}
+
+type VoteEvent struct {
+	ID                   string       `json:"id"`
+	MotionText           string       `json:"motion_text"`
+	MotionClassification []string     `json:"motion_classification"`
+	StartDate            string       `json:"start_date"`
+	Result               string       `json:"result"` // pass, fail
+	Organization         Organization `json:"organization"`
+	Votes                []PersonVote `json:"votes"`
+}
+
+type PersonVote struct {
+	Option    string  `json:"option"` // yes, no, absent, abstain, not voting, excused, other
+	VoterName string  `json:"voter_name"`
+	Voter     *Person `json:"voter"`
+}
+
+// parseDate handles the ISO 8601 dates and datetimes Open States uses
+func parseDate(s string) time.Time {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t
+	}
+	if len(s) >= 10 {
+		if t, err := time.Parse("2006-01-02", s[:10]); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func hasClassification(c []string, v string) bool {
+	for _, cc := range c {
+		if strings.EqualFold(cc, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package openstates
+
+import (
+	"context"
+
+	"github.com/jehiah/legislation.support/internal/legislature"
+)
+
+// Votes returns the committee and floor votes on a bill
+func (o *OpenStates) Votes(ctx context.Context, id legislature.LegislationID) ([]legislature.RollCall, error) {
+	bill, err := o.bill(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return o.rollCalls(*bill), nil
+}
+
+func (o *OpenStates) rollCalls(b Bill) []legislature.RollCall {
+	var out []legislature.RollCall
+	for _, v := range b.Votes {
+		r := legislature.RollCall{
+			Date:    parseDate(v.StartDate),
+			Chamber: v.Organization.Name,
+			Motion:  v.MotionText,
+			Result:  v.Result,
+		}
+		if v.Organization.Classification == "committee" {
+			r.Chamber = o.body.Name
+			r.Committee = v.Organization.Name
+		}
+		for _, pv := range v.Votes {
+			m := legislature.Member{FullName: pv.VoterName}
+			if pv.Voter != nil {
+				m = o.member(*pv.Voter)
+			}
+			r.Votes = append(r.Votes, legislature.MemberVote{
+				Member:   m,
+				Position: legislature.NormalizeVotePosition(pv.Option),
+			})
+		}
+		r.Tally = legislature.TallyVotes(r.Votes)
+		out = append(out, r)
+	}
+	legislature.SortRollCalls(out)
+	return out
+}
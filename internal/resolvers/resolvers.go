@@ -4,89 +4,122 @@ import (
 	"context"
 	"net/url"
 	"os"
+	"slices"
+	"sort"
+	"time"
 
 	"github.com/jehiah/legislation.support/internal/legislature"
-	"github.com/jehiah/legislation.support/internal/resolvers/congress"
-	"github.com/jehiah/legislation.support/internal/resolvers/nyc"
-	"github.com/jehiah/legislation.support/internal/resolvers/nysenate"
+	"github.com/jehiah/legislation.support/internal/resolvers/legiscan"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
 )
 
+// Resolvers and Bodies are empty until Load is called
 var (
-	NYCCouncil = legislature.Body{
-		ID:         "nyc",
-		Name:       "NYC City Council",
-		DisplayID:  "NYC-Council",
-		Location:   "New York City",
-		URL:        "https://council.nyc.gov/",
-		MemberName: "Council Member",
-		Sort:       legislature.GenericLegislationSort,
+	Resolvers legislature.Resolvers
+	Bodies    map[legislature.BodyID]legislature.Body
+)
+
+// Load replaces Resolvers and Bodies from a config file. When path is empty the file in
+// ENV LEGISLATION_SUPPORT_CONFIG is used, or the default config (see default_config.json)
+func Load(path string) error {
+	if path == "" {
+		path = os.Getenv("LEGISLATION_SUPPORT_CONFIG")
 	}
-	NYSenate = legislature.Body{
-		ID:         "nysenate",
-		Bicameral:  "ny-assembly",
-		UpperHouse: true,
-		Name:       "NY Senate",
-		DisplayID:  "NY-Senate",
-		Location:   "New York",
-		URL:        "https://www.nysenate.gov/",
-		MemberName: "Senator",
-		Sort:       nysenate.LegislationSort,
+	c, err := ReadConfig(path)
+	if err != nil {
+		return err
 	}
-	NYAssembly = legislature.Body{
-		ID:         "ny-assembly",
-		Bicameral:  "nysenate",
-		Name:       "NY Assembly",
-		DisplayID:  "NY-Assembly",
-		Location:   "New York",
-		URL:        "https://assembly.state.ny.us/",
-		MemberName: "Assembly Member",
-		Sort:       nysenate.LegislationSort,
+	r, b, err := c.Build()
+	if err != nil {
+		return err
 	}
-	USHouse = legislature.Body{
-		ID:         "us-house",
-		Bicameral:  "us-senate",
-		Name:       "US House of Representatives",
-		DisplayID:  "US-House",
-		Location:   "United States",
-		URL:        "https://www.house.gov/",
-		MemberName: "Representative",
-		Sort:       legislature.GenericLegislationSort,
+	for id := range Bodies {
+		legislature.RegisterCalendar(id, nil)
 	}
-	USSenate = legislature.Body{
-		ID:         "us-senate",
-		Bicameral:  "us-house",
-		UpperHouse: true,
-		Name:       "US Senate",
-		DisplayID:  "US-Senate",
-		Location:   "United States",
-		URL:        "https://www.senate.gov/",
-		MemberName: "Senator",
-		Sort:       legislature.GenericLegislationSort,
+	for id, body := range b {
+		legislature.RegisterCalendar(id, body.Calendar)
 	}
-)
-
-var Resolvers = legislature.Resolvers{
-	nyc.New(NYCCouncil),
-	nysenate.NewNYSenate(NYSenate, os.Getenv("NY_SENATE_TOKEN")),
-	nysenate.NewNYAssembly(NYAssembly, os.Getenv("NY_SENATE_TOKEN")),
-	congress.NewHouse(USHouse, os.Getenv("CONGRESS_GOV_APIKEY")),
-	congress.NewSenate(USSenate, os.Getenv("CONGRESS_GOV_APIKEY")),
+	Resolvers, Bodies = r, b
+	return nil
 }
 
 func Lookup(ctx context.Context, u *url.URL) (*legislature.Legislation, error) {
 	return Resolvers.Lookup(ctx, u)
 }
 
-func SupportedDomains() []string {
-	return Resolvers.SupportedDomains()
+// Search queries the current session of the bodies (and their other chamber) with a resolver that
+// implements legislature.Searcher. LegiScan states are searched with a single query
+func Search(ctx context.Context, query string, bodies []legislature.BodyID) []legislature.Legislation {
+	selected := make(map[legislature.BodyID]bool)
+	for _, b := range bodies {
+		selected[b] = true
+		if c := Bodies[b].Bicameral; c != "" {
+			selected[c] = true
+		}
+	}
+	var searchers []legislature.Searcher
+	var states []*legiscan.LegiScan
+	for _, r := range Resolvers {
+		if !selected[r.Body().ID] {
+			continue
+		}
+		switch s := r.(type) {
+		case *legiscan.LegiScan:
+			states = append(states, s)
+		case legislature.Searcher:
+			searchers = append(searchers, s)
+		}
+	}
+	results := make([][]legislature.Legislation, len(searchers)+1)
+	var g errgroup.Group
+	g.SetLimit(5)
+	for i, s := range searchers {
+		g.Go(func() error {
+			ctx, cancel := context.WithTimeout(ctx, searchTimeout)
+			defer cancel()
+			var err error
+			results[i], err = s.Search(ctx, query, legislature.Session{})
+			if err != nil {
+				log.WithContext(ctx).WithField("body", s.(legislature.Resolver).Body().ID).Warnf("search error %s", err)
+			}
+			return nil
+		})
+	}
+	g.Go(func() error {
+		ctx, cancel := context.WithTimeout(ctx, searchTimeout)
+		defer cancel()
+		var err error
+		results[len(searchers)], err = legiscan.SearchStates(ctx, query, states)
+		if err != nil {
+			log.WithContext(ctx).WithField("states", len(states)).Warnf("legiscan search error %s", err)
+		}
+		return nil
+	})
+	g.Wait()
+	var out []legislature.Legislation
+	for _, r := range results {
+		out = append(out, r...)
+	}
+	return out
 }
 
-var Bodies map[legislature.BodyID]legislature.Body = map[legislature.BodyID]legislature.Body{
-	NYCCouncil.ID: NYCCouncil,
-	NYSenate.ID:   NYSenate,
-	NYAssembly.ID: NYAssembly,
-	USHouse.ID:    USHouse,
-	USSenate.ID:   USSenate,
+// SearchBodies are the available bodies that can be searched, sorted by name
+func SearchBodies() []legislature.Body {
+	var out []legislature.Body
+	for _, r := range Resolvers {
+		if _, ok := r.(legislature.Searcher); ok && IsAvailable(r.Body().ID) {
+			out = append(out, Bodies[r.Body().ID])
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+const searchTimeout = 10 * time.Second
+
+func SupportedDomains() []string {
+	return Resolvers.SupportedDomains()
 }
 
 func IsValidBodyID(ID legislature.BodyID) bool {
@@ -97,3 +130,21 @@ func IsValidBodyID(ID legislature.BodyID) bool {
 func IsBicameral(b legislature.BodyID) bool {
 	return Bodies[b].Bicameral != ""
 }
+
+// IsAvailable is true when the body is configured and its resolver is enabled
+func IsAvailable(b legislature.BodyID) bool {
+	body, ok := Bodies[b]
+	return ok && !body.Unavailable
+}
+
+// AvailableBodies are the configured bodies with an enabled resolver
+func AvailableBodies() []legislature.BodyID {
+	var out []legislature.BodyID
+	for id, b := range Bodies {
+		if !b.Unavailable {
+			out = append(out, id)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
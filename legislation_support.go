@@ -41,13 +41,17 @@ var static embed.FS
 
 type App struct {
 	devMode  bool
-	firebase *auth.Client
+	firebase *auth.Client // nil without Firestore; see devUser
+
+	// devUser (and devEmail) is the signed in user when running without Firebase Auth
+	devUser  account.UID
+	devEmail string
 
 	staticHandler http.Handler
 	templateFS    fs.FS
 	firebaseAuth  http.Handler
 
-	*datastore.Datastore
+	datastore.Store
 }
 
 func commaInt(i int) string {
@@ -61,10 +65,18 @@ func Markdown(md string) template.HTML {
 }
 
 func LegislationLink(b legislature.BodyID, l legislature.LegislationID) template.URL {
-	return template.URL(resolvers.Resolvers.Find(b).Link(l).String())
+	r := resolvers.Resolvers.Find(b)
+	if r == nil {
+		return ""
+	}
+	return template.URL(r.Link(l).String())
 }
 func LegislationDisplayID(b legislature.BodyID, l legislature.LegislationID) string {
-	return resolvers.Resolvers.Find(b).DisplayID(l)
+	r := resolvers.Resolvers.Find(b)
+	if r == nil {
+		return string(l)
+	}
+	return r.DisplayID(l)
 }
 func LookupBody(b legislature.BodyID) legislature.Body {
 	return resolvers.Bodies[b]
@@ -159,7 +171,12 @@ const tsFmt = "2006/01/02 15:04:05"
 func main() {
 	logRequests := flag.Bool("log-requests", false, "log requests")
 	devMode := flag.Bool("dev-mode", false, "development mode")
+	dsn := flag.String("datastore", "firestore", datastore.DSNUsage)
+	configFile := flag.String("config", "", "legislative bodies config file (default: ENV LEGISLATION_SUPPORT_CONFIG or the built-in config)")
+	devUser := flag.String("dev-user", "", "user ID to sign in as without Firebase Auth (only for a non-firestore datastore)")
+	devEmail := flag.String("dev-email", "", "email address for -dev-user")
 	flag.Parse()
+
 	log.SetReportCaller(true)
 	if *devMode {
 		*logRequests = true
@@ -167,6 +184,9 @@ func main() {
 	} else {
 		log.SetFormatter(&fluentdFormatter{})
 	}
+	if err := resolvers.Load(*configFile); err != nil {
+		log.Fatalf("error loading config %s", err)
+	}
 
 	// nyassembly.gov SSL has invalid chain
 	// https://www.ssllabs.com/ssltest/analyze.html?d=nyassembly.gov
@@ -177,22 +197,37 @@ func main() {
 
 	log.Print("starting server...")
 	ctx := context.Background()
-	firebaseApp, err := firebase.NewApp(ctx, &firebase.Config{
-		ProjectID:        "legislation-support",
-		ServiceAccountID: "firebase-adminsdk-q48s8@legislation-support.iam.gserviceaccount.com",
-	})
-	if err != nil {
-		log.Fatal(err)
+	// Firebase Auth needs Google credentials; other datastores run locally with -dev-user
+	var authClient *auth.Client
+	if datastore.IsFirestore(*dsn) {
+		if *devUser != "" {
+			log.Fatal("-dev-user is only supported without firestore")
+		}
+		firebaseApp, err := firebase.NewApp(ctx, &firebase.Config{
+			ProjectID:        "legislation-support",
+			ServiceAccountID: "firebase-adminsdk-q48s8@legislation-support.iam.gserviceaccount.com",
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		authClient, err = firebaseApp.Auth(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+	} else if *devUser != "" {
+		log.Printf("signed in as %q without Firebase Auth", *devUser)
 	}
-	authClient, err := firebaseApp.Auth(ctx)
+	db, err := datastore.Open(ctx, *dsn)
 	if err != nil {
 		log.Fatal(err)
 	}
 	firebase := &url.URL{Scheme: "https", Host: "legislation-support.firebaseapp.com"}
 	app := &App{
 		devMode:       *devMode,
-		Datastore:     datastore.New(datastore.NewClient(ctx)),
+		Store:         db,
 		firebase:      authClient,
+		devUser:       account.UID(*devUser),
+		devEmail:      strings.ToLower(*devEmail),
 		staticHandler: http.FileServer(http.FS(static)),
 		templateFS:    content,
 		firebaseAuth: &httputil.ReverseProxy{
@@ -223,8 +258,33 @@ func main() {
 	router.HandleFunc("GET /{profile}/changes.xml", app.ProfileChanges)  // RSS
 	router.HandleFunc("GET /{profile}/changes.json", app.ProfileChanges) // Json feed
 	router.HandleFunc("GET /{profile}/scorecard/{body}", app.Scorecard)
+	router.HandleFunc("GET /{profile}/votes/{body}/{legislation}", app.ProfileVotes)
+	router.HandleFunc("GET /{profile}/history/{body}/{legislation}", app.ProfileBookmarkHistory)
+	router.HandleFunc("GET /{profile}/export.json", app.ProfileExport)
+	router.HandleFunc("GET /{profile}/export.csv", app.ProfileExport)
 
 	router.HandleFunc("POST /data/profile", app.ProfilePost)
+	router.HandleFunc("POST /data/profile/members", app.ProfileMembersPost)
+	router.HandleFunc("POST /data/profile/rank", app.ProfileRankPost)
+	router.HandleFunc("POST /data/profile/import", app.ProfileImportPost)
+	router.HandleFunc("POST /data/profile/share", app.ProfileSharePost)
+	router.HandleFunc("POST /data/invitation", app.InvitationPost)
+	router.HandleFunc("POST /data/api_tokens", app.APITokensPost)
+
+	router.HandleFunc("GET /api/v1/openapi.json", app.OpenAPI)
+	router.HandleFunc("GET /api/v1/profiles", app.api(account.ProfilesReadScope, app.APIProfiles))
+	router.HandleFunc("POST /api/v1/profiles", app.api(account.ProfilesWriteScope, app.APIProfileCreate))
+	router.HandleFunc("GET /api/v1/profiles/{profile}", app.api(account.ProfilesReadScope, app.APIProfile))
+	router.HandleFunc("PATCH /api/v1/profiles/{profile}", app.api(account.ProfilesWriteScope, app.APIProfileUpdate))
+	router.HandleFunc("DELETE /api/v1/profiles/{profile}", app.api(account.ProfilesWriteScope, app.APIProfileDelete))
+	router.HandleFunc("GET /api/v1/profiles/{profile}/tags", app.api(account.ProfilesReadScope, app.APITags))
+	router.HandleFunc("GET /api/v1/profiles/{profile}/scorecards/{body}", app.api(account.ProfilesReadScope, app.APIScorecard))
+	router.HandleFunc("GET /api/v1/profiles/{profile}/bookmarks", app.api(account.BookmarksReadScope, app.APIBookmarks))
+	router.HandleFunc("POST /api/v1/profiles/{profile}/bookmarks", app.api(account.BookmarksWriteScope, app.APIBookmarkCreate))
+	router.HandleFunc("GET /api/v1/profiles/{profile}/bookmarks/{body}/{legislation}", app.api(account.BookmarksReadScope, app.APIBookmark))
+	router.HandleFunc("PATCH /api/v1/profiles/{profile}/bookmarks/{body}/{legislation}", app.api(account.BookmarksWriteScope, app.APIBookmarkUpdate))
+	router.HandleFunc("DELETE /api/v1/profiles/{profile}/bookmarks/{body}/{legislation}", app.api(account.BookmarksWriteScope, app.APIBookmarkDelete))
+	router.HandleFunc("GET /data/search", app.ProfileSearch)
 	router.HandleFunc("DELETE /data/profile", app.ProfileRemove)
 	router.HandleFunc("POST /data/session", app.NewSession)
 	router.HandleFunc("POST /internal/refresh", app.InternalRefresh)
@@ -3,11 +3,14 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"html"
 	"net/http"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/gorilla/feeds"
+	"github.com/gosimple/slug"
 	"github.com/jehiah/legislation.support/internal/account"
 	"github.com/jehiah/legislation.support/internal/apiresponse"
 	"github.com/jehiah/legislation.support/internal/legislature"
@@ -29,11 +32,61 @@ func (b *BookmarkChange) record(err error) {
 	}
 }
 
+// Change is a SponsorChange, StatusChange or AmendmentChange for a bookmarked bill
 type Change struct {
 	legislature.LegislationID
 	*legislature.Body
 	account.Bookmark
-	legislature.SponsorChange
+	Date            time.Time
+	SponsorChange   *legislature.SponsorChange
+	StatusChange    *legislature.StatusChange
+	AmendmentChange *legislature.AmendmentChange
+}
+
+// FeedID is a stable unique ID for a feed item. A bill can have more than one status change a day
+// so the status is part of the ID
+func (c Change) FeedID() string {
+	switch {
+	case c.SponsorChange != nil:
+		return fmt.Sprintf("%s-%s-%s", c.Legislation.Body, c.Legislation.DisplayID, c.SponsorChange.Member.ID())
+	case c.StatusChange != nil:
+		return fmt.Sprintf("%s-%s-status-%s-%s", c.Legislation.Body, c.Legislation.DisplayID, c.Date.Format("20060102"), slug.Make(c.StatusChange.Status))
+	case c.AmendmentChange != nil:
+		return fmt.Sprintf("%s-%s-amendment-%s", c.Legislation.Body, c.Legislation.DisplayID, c.AmendmentChange.PrintNo)
+	}
+	return fmt.Sprintf("%s-%s-%s", c.Legislation.Body, c.Legislation.DisplayID, c.Date.Format("20060102"))
+}
+
+// appendChanges adds sponsor, status and amendment changes for a bookmark
+func appendChanges(out []Change, id legislature.LegislationID, body *legislature.Body, b account.Bookmark, changes legislature.Changes) []Change {
+	for _, c := range changes.Sponsors {
+		out = append(out, Change{
+			LegislationID: id,
+			Body:          body,
+			Bookmark:      b,
+			Date:          c.Date,
+			SponsorChange: &c,
+		})
+	}
+	for _, c := range changes.Status {
+		out = append(out, Change{
+			LegislationID: id,
+			Body:          body,
+			Bookmark:      b,
+			Date:          c.Date,
+			StatusChange:  &c,
+		})
+	}
+	for _, c := range changes.Amendments {
+		out = append(out, Change{
+			LegislationID:   id,
+			Body:            body,
+			Bookmark:        b,
+			Date:            c.Date,
+			AmendmentChange: &c,
+		})
+	}
+	return out
 }
 
 func (a *App) ProfileChanges(w http.ResponseWriter, r *http.Request) {
@@ -53,7 +106,7 @@ func (a *App) ProfileChanges(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if redirect != nil {
-		http.Redirect(w, r, redirect.To.Link()+"/changes", http.StatusPermanentRedirect)
+		http.Redirect(w, r, withQuery(redirect.To.Link()+"/changes", r), http.StatusPermanentRedirect)
 		return
 	}
 
@@ -68,8 +121,8 @@ func (a *App) ProfileChanges(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if uid == "" && profile.Private {
-		a.WebPermissionError403(w, "")
+	key, ok := a.canView(w, r, uid, *profile)
+	if !ok {
 		return
 	}
 
@@ -81,14 +134,16 @@ func (a *App) ProfileChanges(w http.ResponseWriter, r *http.Request) {
 		Title    string
 		Message  Message
 		UID      account.UID
+		Key      string // share key
 		Profile  account.Profile
 		EditMode bool
 		Changes  []Change
 	}
 	body := Page{
-		Title:   profile.Name + " Recent Sponsor Changes",
+		Title:   profile.Name + " Recent Changes",
 		Profile: *profile,
 		UID:     uid,
+		Key:     key,
 	}
 
 	b, err := a.GetProfileChanges(ctx, profileID)
@@ -98,31 +153,19 @@ func (a *App) ProfileChanges(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	for _, bb := range b {
-		if !bb.Legislation.Session.Active() {
+		if !bb.Legislation.Active() {
 			continue
 		}
 
-		for _, c := range bb.Changes.Sponsors {
-			body.Changes = append(body.Changes, Change{
-				LegislationID: bb.LegislationID,
-				Body:          bb.Body,
-				Bookmark:      bb.Bookmark,
-				SponsorChange: c,
-			})
-		}
-		for _, c := range bb.SameAsChanges.Sponsors {
+		body.Changes = appendChanges(body.Changes, bb.LegislationID, bb.Body, bb.Bookmark, bb.Changes)
+		if bb.Legislation.SameAs != "" {
 			sameAsBody := resolvers.Bodies[bb.Body.Bicameral]
-			body.Changes = append(body.Changes, Change{
-				LegislationID: bb.Legislation.SameAs,
-				Body:          &sameAsBody,
-				Bookmark:      bb.Bookmark,
-				SponsorChange: c,
-			})
+			body.Changes = appendChanges(body.Changes, bb.Legislation.SameAs, &sameAsBody, bb.Bookmark, bb.SameAsChanges)
 		}
 	}
 
 	sort.Slice(body.Changes, func(i, j int) bool {
-		return body.Changes[i].SponsorChange.Date.After(body.Changes[j].SponsorChange.Date)
+		return body.Changes[i].Date.After(body.Changes[j].Date)
 	})
 
 	// only show the last ~100 changes? 200?
@@ -131,11 +174,11 @@ func (a *App) ProfileChanges(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if strings.HasSuffix(r.URL.Path, "/changes.json") {
-		a.ProfileChangesJSON(w, r, *profile, body.Changes)
+		a.ProfileChangesJSON(w, r, *profile, key, body.Changes)
 		return
 	}
 	if strings.HasSuffix(r.URL.Path, "/changes.xml") {
-		a.ProfileChangesRSS(w, r, *profile, body.Changes)
+		a.ProfileChangesRSS(w, r, *profile, key, body.Changes)
 		return
 	}
 
@@ -146,27 +189,31 @@ func (a *App) ProfileChanges(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (a *App) ProfileChangesRSS(w http.ResponseWriter, r *http.Request, profile account.Profile, changes []Change) {
+func (a *App) ProfileChangesRSS(w http.ResponseWriter, r *http.Request, profile account.Profile, key string, changes []Change) {
 	feed := &feeds.Feed{
 		Title:       profile.Name,
-		Link:        &feeds.Link{Href: profile.FullLink() + "/changes"},
-		Description: "Recent Sponsor Changes",
+		Link:        &feeds.Link{Href: profile.FullLink() + "/changes" + keyQuery(key)},
+		Description: "Recent Changes",
 	}
 
 	for _, c := range changes {
-		action := "Sponsored"
-		if c.SponsorChange.Withdraw {
-			action = "Sponsor Withdrawn"
-		}
-
-		feed.Items = append(feed.Items, &feeds.Item{
-			Title:       fmt.Sprintf("%s %s by %s %s", c.Legislation.DisplayID, action, c.Body.MemberName, c.SponsorChange.Member.FullName),
+		item := &feeds.Item{
+			Id:          c.FeedID(),
 			Link:        &feeds.Link{Href: c.Legislation.URL},
 			Description: c.Legislation.Title,
-			Id:          fmt.Sprintf("%s-%s-%s", c.Legislation.Body, c.Legislation.DisplayID, c.SponsorChange.Member.ID()),
-			Created:     c.SponsorChange.Date,
-			Updated:     c.SponsorChange.Date,
-		})
+			Created:     c.Date,
+			Updated:     c.Date,
+		}
+		switch {
+		case c.SponsorChange != nil:
+			item.Title = fmt.Sprintf("%s %s by %s %s", c.Legislation.DisplayID, c.SponsorChange.Action(), c.Body.MemberName, c.SponsorChange.Member.FullName)
+		case c.StatusChange != nil:
+			item.Title = fmt.Sprintf("%s %s", c.Legislation.DisplayID, c.StatusChange.Status)
+		case c.AmendmentChange != nil:
+			item.Title = amendmentTitle(c.AmendmentChange)
+			item.Content = "<pre>" + html.EscapeString(c.AmendmentChange.Diff) + "</pre>"
+		}
+		feed.Items = append(feed.Items, item)
 	}
 
 	w.Header().Set("Content-Type", "application/atom+xml")
@@ -178,11 +225,11 @@ func (a *App) ProfileChangesRSS(w http.ResponseWriter, r *http.Request, profile
 
 }
 
-func (a *App) ProfileChangesJSON(w http.ResponseWriter, r *http.Request, profile account.Profile, changes []Change) {
+func (a *App) ProfileChangesJSON(w http.ResponseWriter, r *http.Request, profile account.Profile, key string, changes []Change) {
 	feed := &feeds.JSONFeed{
 		Title:       profile.Name,
-		HomePageUrl: profile.FullLink(),
-		FeedUrl:     profile.FullLink() + "/changes.json",
+		HomePageUrl: profile.FullLink() + keyQuery(key),
+		FeedUrl:     profile.FullLink() + "/changes.json" + keyQuery(key),
 		Version:     "https://jsonfeed.org/version/1",
 		// Description: "...",
 		// Author:  &feeds.Author{Name: "John Doe", Email: "user@email"},
@@ -196,14 +243,24 @@ func (a *App) ProfileChangesJSON(w http.ResponseWriter, r *http.Request, profile
 	}
 
 	for _, c := range changes {
-		feed.Items = append(feed.Items, &feeds.JSONItem{
-			Title: fmt.Sprintf("%s Sponsored by %s", c.Legislation.DisplayID, c.SponsorChange.Member.FullName),
+		item := &feeds.JSONItem{
+			Id: c.FeedID(),
 			// Link:  &feeds.Link{Href: u.String()},
 			Summary:       c.Legislation.Title,
-			Author:        &feeds.JSONAuthor{Name: c.SponsorChange.Member.FullName}, // , Email: c.SponsorChange.Member.Email},
-			PublishedDate: &c.SponsorChange.Date,
-			ModifiedDate:  &c.SponsorChange.Date,
-		})
+			PublishedDate: &c.Date,
+			ModifiedDate:  &c.Date,
+		}
+		switch {
+		case c.SponsorChange != nil:
+			item.Title = fmt.Sprintf("%s %s by %s", c.Legislation.DisplayID, c.SponsorChange.Action(), c.SponsorChange.Member.FullName)
+			item.Author = &feeds.JSONAuthor{Name: c.SponsorChange.Member.FullName} // , Email: c.SponsorChange.Member.Email},
+		case c.StatusChange != nil:
+			item.Title = fmt.Sprintf("%s %s", c.Legislation.DisplayID, c.StatusChange.Status)
+		case c.AmendmentChange != nil:
+			item.Title = amendmentTitle(c.AmendmentChange)
+			item.ContentText = c.AmendmentChange.Diff
+		}
+		feed.Items = append(feed.Items, item)
 	}
 
 	w.Header().Set("Content-Type", "application/feed+json")
@@ -214,3 +271,11 @@ func (a *App) ProfileChangesJSON(w http.ResponseWriter, r *http.Request, profile
 	}
 
 }
+
+// amendmentTitle i.e. "S1234A Amended (from S1234)"
+func amendmentTitle(c *legislature.AmendmentChange) string {
+	if c.FromPrintNo == "" {
+		return fmt.Sprintf("%s Amended", c.PrintNo)
+	}
+	return fmt.Sprintf("%s Amended (from %s)", c.PrintNo, c.FromPrintNo)
+}
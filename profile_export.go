@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/account"
+	"github.com/jehiah/legislation.support/internal/apiresponse"
+	"github.com/jehiah/legislation.support/internal/resolvers"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	maxImportSize        = 10 << 20 // 10Mb
+	maxImportRows        = 1000
+	maxImportConcurrency = 5
+)
+
+// ProfileExport downloads all of a profile's bookmarks; the JSON export also includes the bookmark history.
+// It's limited to profile members
+// GET /{profile}/export.json
+// GET /{profile}/export.csv
+func (a *App) ProfileExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	profileID := account.ProfileID(r.PathValue("profile"))
+	if !account.IsValidProfileID(profileID) {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+	uid := a.User(r)
+	fields := log.Fields{"uid": uid, "profileID": profileID}
+
+	profile, err := a.GetProfile(ctx, profileID)
+	if err != nil {
+		log.WithFields(fields).Errorf("%#v", err)
+		a.WebInternalError500(w, "")
+		return
+	}
+	if profile == nil {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+	if !profile.Role(uid).CanView() {
+		a.WebPermissionError403(w, "")
+		return
+	}
+
+	bookmarks, err := a.GetProfileBookmarks(ctx, profileID)
+	if err != nil {
+		log.WithFields(fields).Errorf("%#v", err)
+		a.WebInternalError500(w, "")
+		return
+	}
+	sort.Sort(account.SortedBookmarks(bookmarks))
+
+	if strings.HasSuffix(r.URL.Path, ".csv") {
+		e := account.NewProfileExport(*profile, bookmarks, nil, time.Now().UTC())
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", string(profileID)+".csv"))
+		if err = account.WriteExportCSV(w, e.Bookmarks); err != nil {
+			log.WithFields(fields).Error(err)
+		}
+		return
+	}
+
+	history, err := a.GetProfileBookmarkHistory(ctx, profileID)
+	if err != nil {
+		log.WithFields(fields).Errorf("%#v", err)
+		a.WebInternalError500(w, "")
+		return
+	}
+	sort.Sort(account.SortedBookmarkHistory(history))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", string(profileID)+".json"))
+	apiresponse.OK200(w, account.NewProfileExport(*profile, bookmarks, history, time.Now().UTC()))
+}
+
+// ImportStatus is the result of importing (or previewing) an ImportRow
+type ImportStatus string
+
+const (
+	ImportNew     ImportStatus = "new"    // dry run: legislation found, not yet bookmarked
+	ImportExists  ImportStatus = "exists" // dry run: already bookmarked; it will be updated
+	ImportAdded   ImportStatus = "added"
+	ImportUpdated ImportStatus = "updated"
+	ImportError   ImportStatus = "error"
+)
+
+// ImportRow is the outcome for a bookmark in an import file
+type ImportRow struct {
+	Row       int // 1 is the first bookmark
+	Input     string
+	Status    ImportStatus
+	Error     string `json:",omitempty"`
+	Body      string `json:",omitempty"`
+	DisplayID string `json:",omitempty"`
+	Title     string `json:",omitempty"`
+}
+
+type ImportResult struct {
+	DryRun bool
+	Rows   []ImportRow
+}
+
+// ProfileImportPost imports a JSON or CSV export (see ProfileExport) into a profile. With dry_run=on nothing
+// is saved; each row is looked up to preview which resolve, which fail and which are already bookmarked.
+// Bookmark history is not imported; imported changes are recorded as new history
+// POST /data/profile/import
+//
+// profile_id=... file=... dry_run=on
+func (a *App) ProfileImportPost(w http.ResponseWriter, r *http.Request) {
+	r.ParseMultipartForm(maxImportSize)
+	ctx := r.Context()
+	uid := a.User(r)
+
+	profileID := account.ProfileID(r.Form.Get("profile_id"))
+	logFields := log.Fields{"uid": uid, "profileID": profileID}
+
+	profile, err := a.GetProfile(ctx, profileID)
+	if err != nil {
+		log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+		apiresponse.InternalError500(w)
+		return
+	}
+	if profile == nil {
+		apiresponse.NotFound404(w)
+		return
+	}
+	if !profile.Role(uid).CanEdit() {
+		apiresponse.Error(w, "Permission Denied", http.StatusForbidden)
+		return
+	}
+
+	f, _, err := r.FormFile("file")
+	if err != nil {
+		apiresponse.BadRequest400(w, "file required")
+		return
+	}
+	defer f.Close()
+	rows, err := account.ReadExport(f)
+	if err != nil {
+		apiresponse.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	if len(rows) > maxImportRows {
+		apiresponse.Error(w, fmt.Sprintf("too many bookmarks (%d); the limit is %d", len(rows), maxImportRows), http.StatusUnprocessableEntity)
+		return
+	}
+
+	result := ImportResult{
+		DryRun: r.Form.Get("dry_run") == "on",
+		Rows:   make([]ImportRow, len(rows)),
+	}
+	log.WithContext(ctx).WithFields(logFields).Infof("importing %d bookmarks (dry run %v)", len(rows), result.DryRun)
+	limit := make(chan bool, maxImportConcurrency)
+	var wg sync.WaitGroup
+	for i, row := range rows {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			limit <- true
+			defer func() { <-limit }()
+			o := ImportRow{Row: i + 1, Input: row.Input()}
+			if result.DryRun {
+				a.previewImport(ctx, profileID, &o)
+			} else {
+				a.importBookmark(ctx, uid, profileID, row, &o)
+			}
+			result.Rows[i] = o
+		}()
+	}
+	wg.Wait()
+	apiresponse.OK200(w, result)
+}
+
+// previewImport looks up the legislation for o.Input the same way as adding a bookmark, without saving
+func (a *App) previewImport(ctx context.Context, profileID account.ProfileID, o *ImportRow) {
+	bill, err := resolvers.LookupInput(ctx, o.Input)
+	if err != nil {
+		o.Status, o.Error = ImportError, err.Error()
+		return
+	}
+	o.Body, o.DisplayID, o.Title = resolvers.Bodies[bill.Body].Name, bill.DisplayID, bill.Title
+	existing, err := a.GetBookmark(ctx, profileID, account.BookmarkKey(bill.Body, bill.ID))
+	switch {
+	case err != nil:
+		o.Status, o.Error = ImportError, err.Error()
+	case existing != nil:
+		o.Status = ImportExists
+	default:
+		o.Status = ImportNew
+	}
+}
+
+// importBookmark adds (or updates) a bookmark from an export
+func (a *App) importBookmark(ctx context.Context, uid account.UID, profileID account.ProfileID, row account.ExportedBookmark, o *ImportRow) {
+	c := a.saveBookmark(ctx, uid, profileID, o.Input, account.BookmarkEdit{
+		Position: &row.Position,
+		Notes:    &row.Notes,
+		Tags:     ptr(row.Tags),
+		Rank:     &row.Rank,
+		Weight:   &row.Weight,
+		Created:  row.Created,
+	})
+	if c.Error != "" {
+		o.Status, o.Error = ImportError, c.Error
+		return
+	}
+	o.Status = ImportUpdated
+	if c.New {
+		o.Status = ImportAdded
+	}
+	if c.Body != nil {
+		o.Body = c.Body.Name
+	}
+	if c.Legislation != nil {
+		o.DisplayID, o.Title = c.Legislation.DisplayID, c.Legislation.Title
+	}
+}
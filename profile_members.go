@@ -0,0 +1,133 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/account"
+	"github.com/jehiah/legislation.support/internal/apiresponse"
+	log "github.com/sirupsen/logrus"
+)
+
+// ProfileMembersPost invites, updates and removes profile members
+// POST /data/profile/members
+//
+// action=invite email=... role=...
+// action=revoke email=...
+// action=role uid=... role=...
+// action=remove uid=... (members can remove themselves)
+func (a *App) ProfileMembersPost(w http.ResponseWriter, r *http.Request) {
+	r.ParseMultipartForm(1 << 20)
+	ctx := r.Context()
+	uid := a.User(r)
+
+	profileID := account.ProfileID(r.Form.Get("profile_id"))
+	action := r.Form.Get("action")
+	logFields := log.Fields{"uid": uid, "profileID": profileID, "action": action}
+
+	profile, err := a.GetProfile(ctx, profileID)
+	if err != nil {
+		log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+		apiresponse.InternalError500(w)
+		return
+	}
+	if profile == nil {
+		apiresponse.NotFound404(w)
+		return
+	}
+
+	member := account.UID(r.Form.Get("uid"))
+	role := account.Role(r.Form.Get("role"))
+	leave := action == "remove" && member == uid && uid != ""
+	if !profile.Role(uid).CanManage() && !leave {
+		apiresponse.Error(w, "Permission Denied", http.StatusForbidden)
+		return
+	}
+
+	var m Message
+	switch action {
+	case "invite":
+		var i account.Invitation
+		i, err = profile.Invite(r.Form.Get("email"), role, uid, time.Now().UTC())
+		if err == nil {
+			m.Success = fmt.Sprintf("Invited %s as %s. They can accept the invitation after signing in with that email address.", i.Email, i.Role)
+		}
+	case "revoke":
+		profile.RevokeInvitation(r.Form.Get("email"))
+		m.Success = "Invitation removed"
+	case "role":
+		err = profile.SetRole(member, role)
+		m.Success = "Role updated"
+	case "remove":
+		err = profile.RemoveMember(member)
+		m.Success = "Member removed"
+	default:
+		apiresponse.BadRequest400(w, "INVALID_ACTION")
+		return
+	}
+	if err != nil {
+		log.WithContext(ctx).WithFields(logFields).Infof("%s", err)
+		apiresponse.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	err = a.UpdateProfile(ctx, *profile)
+	if err != nil {
+		log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+		apiresponse.InternalError500(w)
+		return
+	}
+	apiresponse.OK200(w, m)
+}
+
+// InvitationPost accepts or declines an invitation to the signed in user's email
+// POST /data/invitation
+func (a *App) InvitationPost(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+	ctx := r.Context()
+	uid := a.User(r)
+	if uid == "" {
+		http.Redirect(w, r, "/sign_in", 302)
+		return
+	}
+	email := a.UserEmail(r)
+	profileID := account.ProfileID(r.PostForm.Get("profile_id"))
+	logFields := log.Fields{"uid": uid, "profileID": profileID}
+
+	profile, err := a.GetProfile(ctx, profileID)
+	if err != nil {
+		log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+		a.WebInternalError500(w, "")
+		return
+	}
+	if profile == nil {
+		a.WebError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	if _, ok := profile.Invitation(email, time.Now().UTC()); !ok {
+		a.WebError(w, http.StatusNotFound, account.ErrInvitationInvalid.Error())
+		return
+	}
+
+	redirect := profile.Link()
+	if r.PostForm.Get("action") == "decline" {
+		profile.RevokeInvitation(email)
+		redirect = "/"
+	} else {
+		err = profile.AcceptInvitation(email, uid, time.Now().UTC())
+		if err != nil && !errors.Is(err, account.ErrAlreadyMember) {
+			a.WebError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+	}
+	err = a.UpdateProfile(ctx, *profile)
+	if err != nil {
+		log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+		a.WebInternalError500(w, "")
+		return
+	}
+	log.WithContext(ctx).WithFields(logFields).Infof("invitation %s", r.PostForm.Get("action"))
+	http.Redirect(w, r, redirect, 302)
+}
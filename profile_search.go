@@ -0,0 +1,103 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/jehiah/legislation.support/internal/account"
+	"github.com/jehiah/legislation.support/internal/apiresponse"
+	"github.com/jehiah/legislation.support/internal/legislature"
+	"github.com/jehiah/legislation.support/internal/resolvers"
+	log "github.com/sirupsen/logrus"
+)
+
+type SearchResult struct {
+	BodyID        legislature.BodyID
+	BodyName      string
+	LegislationID legislature.LegislationID
+	DisplayID     string
+	Title         string
+	Status        string `json:",omitempty"`
+	URL           string // used to add the bookmark
+	Link          string
+	Bookmarked    bool
+}
+
+// ProfileSearch searches legislation in the current session of the selected bodies, or of the bodies
+// the profile already tracks
+// GET /data/search?profile_id=...&q=...&body=...
+func (a *App) ProfileSearch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	uid := a.User(r)
+	profileID := account.ProfileID(r.FormValue("profile_id"))
+	query := strings.TrimSpace(r.FormValue("q"))
+	logFields := log.Fields{"uid": uid, "profileID": profileID, "q": query}
+
+	profile, err := a.GetProfile(ctx, profileID)
+	if err != nil {
+		log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+		apiresponse.InternalError500(w)
+		return
+	}
+	if profile == nil {
+		apiresponse.NotFound404(w)
+		return
+	}
+	if !profile.HasAccess(uid) {
+		apiresponse.Error(w, "Permission Denied", 403)
+		return
+	}
+	if len(query) < 2 {
+		apiresponse.BadRequest400(w, "QUERY_TOO_SHORT")
+		return
+	}
+
+	bookmarks, err := a.GetProfileBookmarks(ctx, profileID)
+	if err != nil {
+		log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+		apiresponse.InternalError500(w)
+		return
+	}
+	bookmarked := make(map[string]bool, len(bookmarks))
+	for _, b := range bookmarks {
+		bookmarked[b.Key()] = true
+	}
+
+	out := make([]SearchResult, 0)
+	var bodies []legislature.BodyID
+	for _, b := range r.Form["body"] {
+		if b := legislature.BodyID(b); resolvers.IsAvailable(b) {
+			bodies = append(bodies, b)
+		}
+	}
+	if len(bodies) == 0 {
+		bodies = bookmarks.Bodies()
+	}
+	if len(bodies) == 0 {
+		apiresponse.BadRequest400(w, "SELECT_BODY")
+		return
+	}
+	logFields["bodies"] = bodies
+	for _, l := range resolvers.Search(ctx, query, bodies) {
+		if l.URL == "" {
+			continue
+		}
+		displayID := l.DisplayID
+		if displayID == "" {
+			displayID = LegislationDisplayID(l.Body, l.ID)
+		}
+		out = append(out, SearchResult{
+			BodyID:        l.Body,
+			BodyName:      resolvers.Bodies[l.Body].Name,
+			LegislationID: l.ID,
+			DisplayID:     displayID,
+			Title:         l.Title,
+			Status:        l.Status,
+			URL:           l.URL,
+			Link:          string(LegislationLink(l.Body, l.ID)),
+			Bookmarked:    bookmarked[account.BookmarkKey(l.Body, l.ID)],
+		})
+	}
+	log.WithContext(ctx).WithFields(logFields).Infof("search found %d results", len(out))
+	apiresponse.OK200(w, out)
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/jehiah/legislation.support/internal/account"
+	"github.com/jehiah/legislation.support/internal/apiresponse"
+	log "github.com/sirupsen/logrus"
+)
+
+// canView checks that uid (or the ?key= share key) can read the profile and writes a 403 when not.
+//
+// It returns the share key used so links to other profile pages can include it
+func (a *App) canView(w http.ResponseWriter, r *http.Request, uid account.UID, profile account.Profile) (key string, ok bool) {
+	key = r.URL.Query().Get("key")
+	if !profile.CanView(uid, key) {
+		a.WebPermissionError403(w, "")
+		return "", false
+	}
+	if !profile.Private {
+		return "", true
+	}
+	// don't index private pages or leak a share key to linked sites
+	w.Header().Set("X-Robots-Tag", "noindex")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.Header().Set("Cache-Control", "private")
+	if !profile.IsShareKey(key) {
+		key = ""
+	}
+	return key, true
+}
+
+// keyQuery is the query string for a share key
+func keyQuery(key string) string {
+	if key == "" {
+		return ""
+	}
+	return "?key=" + url.QueryEscape(key)
+}
+
+// withQuery adds the request query string (i.e. a share key) to a redirect
+func withQuery(link string, r *http.Request) string {
+	if r.URL.RawQuery == "" {
+		return link
+	}
+	return link + "?" + r.URL.RawQuery
+}
+
+// ProfileSharePost creates and revokes share links for a private profile
+// POST /data/profile/share
+//
+// action=create label=...
+// action=revoke key=...
+func (a *App) ProfileSharePost(w http.ResponseWriter, r *http.Request) {
+	r.ParseMultipartForm(1 << 20)
+	ctx := r.Context()
+	uid := a.User(r)
+
+	profileID := account.ProfileID(r.Form.Get("profile_id"))
+	action := r.Form.Get("action")
+	logFields := log.Fields{"uid": uid, "profileID": profileID, "action": action}
+
+	profile, err := a.GetProfile(ctx, profileID)
+	if err != nil {
+		log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+		apiresponse.InternalError500(w)
+		return
+	}
+	if profile == nil {
+		apiresponse.NotFound404(w)
+		return
+	}
+	if !profile.Role(uid).CanManage() {
+		apiresponse.Error(w, "Permission Denied", http.StatusForbidden)
+		return
+	}
+
+	var m Message
+	switch action {
+	case "create":
+		var k account.ShareKey
+		k, err = profile.AddShareKey(r.Form.Get("label"), uid, time.Now().UTC())
+		m.Success = "Share link created " + profile.FullLink() + keyQuery(k.Key)
+	case "revoke":
+		err = profile.RevokeShareKey(r.Form.Get("key"))
+		m.Success = "Share link revoked"
+	default:
+		apiresponse.BadRequest400(w, "INVALID_ACTION")
+		return
+	}
+	if err != nil {
+		log.WithContext(ctx).WithFields(logFields).Infof("%s", err)
+		apiresponse.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	err = a.UpdateProfile(ctx, *profile)
+	if err != nil {
+		log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+		apiresponse.InternalError500(w)
+		return
+	}
+	apiresponse.OK200(w, m)
+}
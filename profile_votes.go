@@ -0,0 +1,107 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/jehiah/legislation.support/internal/account"
+	"github.com/jehiah/legislation.support/internal/legislature"
+	"github.com/jehiah/legislation.support/internal/resolvers"
+	log "github.com/sirupsen/logrus"
+)
+
+// ProfileVotes shows the committee and floor votes for a bookmarked bill (or its same-as bill)
+func (a *App) ProfileVotes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	profileID := account.ProfileID(r.PathValue("profile"))
+	if !account.IsValidProfileID(profileID) {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+	bodyID := legislature.BodyID(r.PathValue("body"))
+	if !resolvers.IsValidBodyID(bodyID) {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+	legislationID := legislature.LegislationID(r.PathValue("legislation"))
+
+	uid := a.User(r)
+	fields := log.Fields{"uid": uid, "profileID": profileID, "body": bodyID, "legislation": legislationID}
+
+	profile, err := a.GetProfile(ctx, profileID)
+	if err != nil {
+		log.WithFields(fields).Errorf("%#v", err)
+		a.WebInternalError500(w, "")
+		return
+	}
+	if profile == nil {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+	key, ok := a.canView(w, r, uid, *profile)
+	if !ok {
+		return
+	}
+
+	bookmarks, err := a.GetProfileBookmarks(ctx, profileID)
+	if err != nil {
+		log.WithFields(fields).Errorf("%#v", err)
+		a.WebInternalError500(w, "")
+		return
+	}
+	var bookmark *account.Bookmark
+	for i, b := range bookmarks {
+		if b.BodyID == bodyID && b.LegislationID == legislationID {
+			bookmark = &bookmarks[i]
+			break
+		}
+		if b.Legislation != nil && b.Legislation.SameAs == legislationID && resolvers.Bodies[b.BodyID].Bicameral == bodyID {
+			bookmark = &bookmarks[i]
+			break
+		}
+	}
+	if bookmark == nil {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+
+	body, ok := resolvers.Bodies[bodyID]
+	if !ok {
+		http.Error(w, "Not Found", 404)
+		return
+	}
+	votes, err := resolvers.Resolvers.Find(bodyID).Votes(ctx, legislationID)
+	if err != nil && !errors.Is(err, legislature.ErrNotFound) && !errors.Is(err, legislature.ErrUnavailable) {
+		log.WithFields(fields).Errorf("%#v", err)
+		a.WebInternalError500(w, "")
+		return
+	}
+
+	type Page struct {
+		Page          string
+		Title         string
+		UID           account.UID
+		Key           string // share key
+		Profile       account.Profile
+		Body          legislature.Body
+		LegislationID legislature.LegislationID
+		Bookmark      account.Bookmark
+		RollCalls     []legislature.RollCall
+	}
+	templateName := "profile_votes.html"
+	t := newTemplate(a.templateFS, templateName)
+	err = t.ExecuteTemplate(w, templateName, Page{
+		Title:         profile.Name + " " + LegislationDisplayID(bodyID, legislationID) + " Votes",
+		UID:           uid,
+		Key:           key,
+		Profile:       *profile,
+		Body:          body,
+		LegislationID: legislationID,
+		Bookmark:      *bookmark,
+		RollCalls:     votes,
+	})
+	if err != nil {
+		log.WithFields(fields).Error(err)
+		a.WebInternalError500(w, "")
+	}
+}
@@ -2,10 +2,12 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
-	"net/url"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -21,9 +23,11 @@ import (
 
 type ProfileMetadata struct {
 	account.Profile
+	Role account.Role
 
 	SupportedBills int
 	OpposedBills   int
+	WatchedBills   int // watched or neutral
 	ArchivedBills  int
 }
 
@@ -36,14 +40,18 @@ func (a *App) Index(w http.ResponseWriter, r *http.Request) {
 	}
 
 	type Page struct {
-		Page     string
-		Title    string
-		UID      account.UID
-		Profiles []ProfileMetadata
+		Page        string
+		Title       string
+		UID         account.UID
+		Profiles    []ProfileMetadata
+		Invitations []account.Profile
+		APITokens   []account.APIToken
+		Scopes      []account.Scope
 	}
 	body := Page{
-		Title: "Legislation Profiles",
-		UID:   uid,
+		Title:  "Legislation Profiles",
+		UID:    uid,
+		Scopes: account.Scopes,
 	}
 
 	profiles, err := a.GetProfiles(ctx, uid)
@@ -53,9 +61,30 @@ func (a *App) Index(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	email := a.UserEmail(r)
+	invitations, err := a.GetInvitations(ctx, email)
+	if err != nil {
+		log.Print(err)
+		a.WebInternalError500(w, "")
+		return
+	}
+	for _, p := range invitations {
+		if _, ok := p.Invitation(email, time.Now().UTC()); ok {
+			body.Invitations = append(body.Invitations, p)
+		}
+	}
+
+	body.APITokens, err = a.GetAPITokens(ctx, uid)
+	if err != nil {
+		log.Print(err)
+		a.WebInternalError500(w, "")
+		return
+	}
+
 	for _, p := range profiles {
 		profile := ProfileMetadata{
 			Profile: p,
+			Role:    p.Role(uid),
 		}
 		b, err := a.GetProfileBookmarks(ctx, p.ID)
 		if err != nil {
@@ -67,11 +96,14 @@ func (a *App) Index(w http.ResponseWriter, r *http.Request) {
 			if bb.LastModified.After(profile.LastModified) {
 				profile.LastModified = bb.LastModified
 			}
-			if bb.Legislation.Session.Active() {
-				if bb.Oppose {
-					profile.OpposedBills++
-				} else {
+			if bb.Legislation.Active() {
+				switch p := bb.GetPosition(); {
+				case p.IsSupport():
 					profile.SupportedBills++
+				case p == account.OpposePosition:
+					profile.OpposedBills++
+				default:
+					profile.WatchedBills++
 				}
 			} else {
 				profile.ArchivedBills++
@@ -93,26 +125,25 @@ func (a *App) Index(w http.ResponseWriter, r *http.Request) {
 	return
 }
 
-func (a *App) IndexPost(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	uid := a.User(r)
-	if uid == "" {
-		http.Redirect(w, r, "/", 302)
-		return
-	}
-	r.ParseForm()
+var (
+	errInvalidProfileID = errors.New("invalid profile ID")
+	errProfileTaken     = errors.New("profile is already taken")
+)
 
+// createProfile creates a new profile owned by uid
+func (a *App) createProfile(ctx context.Context, uid account.UID, id account.ProfileID, name string) (*account.Profile, error) {
+	now := time.Now().UTC()
 	profile := account.Profile{
-		Name:    strings.TrimSpace(r.PostForm.Get("name")),
-		ID:      account.ProfileID(r.PostForm.Get("id")),
-		UID:     uid,
-		Created: time.Now().UTC(),
+		Name:         strings.TrimSpace(name),
+		ID:           id,
+		UID:          uid,
+		Created:      now,
+		LastModified: now,
 	}
 
 	if !account.IsValidProfileID(profile.ID) {
 		log.WithField("uid", uid).Infof("profile ID %q is invalid", profile.ID)
-		http.Error(w, fmt.Sprintf("profile ID %q is invalid", profile.ID), 422)
-		return
+		return nil, fmt.Errorf("%w %q", errInvalidProfileID, profile.ID)
 	}
 
 	if profile.Name == "" {
@@ -121,24 +152,40 @@ func (a *App) IndexPost(w http.ResponseWriter, r *http.Request) {
 
 	rd, err := a.GetRedirect(ctx, profile.ID)
 	if err != nil {
-		log.WithField("uid", uid).WithField("profileID", profile.ID).Errorf("%#v", err)
-		a.WebInternalError500(w, "")
-		return
+		return nil, err
 	}
 	if rd != nil {
-		http.Error(w, fmt.Sprintf("profile %q is already taken", profile.ID), 409)
-		// apiresponse.BadRequest400(w, "PROFILE_EXISTS")
-		return
+		return nil, fmt.Errorf("%q %w", profile.ID, errProfileTaken)
 	}
 
 	err = a.CreateProfile(ctx, profile)
 	if err != nil {
 		if datastore.IsAlreadyExists(err) {
-			http.Error(w, fmt.Sprintf("profile %q is already taken", profile.ID), 409)
-			// apiresponse.Error(w, "PROFILE_EXISTS", 409)
-			return
+			return nil, fmt.Errorf("%q %w", profile.ID, errProfileTaken)
 		}
-		// duplicate?
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (a *App) IndexPost(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	uid := a.User(r)
+	if uid == "" {
+		http.Redirect(w, r, "/", 302)
+		return
+	}
+	r.ParseForm()
+
+	profile, err := a.createProfile(ctx, uid, account.ProfileID(r.PostForm.Get("id")), r.PostForm.Get("name"))
+	switch {
+	case errors.Is(err, errInvalidProfileID):
+		http.Error(w, err.Error(), 422)
+		return
+	case errors.Is(err, errProfileTaken):
+		http.Error(w, err.Error(), 409)
+		return
+	case err != nil:
 		log.WithField("uid", uid).Warningf("%#v %s", err, err)
 		apiresponse.InternalError500(w)
 		return
@@ -168,7 +215,7 @@ func (a *App) Profile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if redirect != nil {
-		http.Redirect(w, r, redirect.To.Link(), http.StatusPermanentRedirect)
+		http.Redirect(w, r, withQuery(redirect.To.Link(), r), http.StatusPermanentRedirect)
 		return
 	}
 
@@ -183,37 +230,47 @@ func (a *App) Profile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if uid == "" && profile.Private {
-		a.WebPermissionError403(w, "")
+	key, ok := a.canView(w, r, uid, *profile)
+	if !ok {
 		return
 	}
-	a.ShowProfile(w, ctx, r, uid, profile, Message{})
+	a.ShowProfile(w, ctx, r, uid, key, profile, Message{})
 }
 
-func (a *App) ShowProfile(w http.ResponseWriter, ctx context.Context, r *http.Request, uid account.UID, profile *account.Profile, message Message) {
+// ShowProfile renders a profile; key is the share key used to view a private profile
+func (a *App) ShowProfile(w http.ResponseWriter, ctx context.Context, r *http.Request, uid account.UID, key string, profile *account.Profile, message Message) {
 	templateName := "profile.html"
 	t := newTemplate(a.templateFS, "profile.html")
 	profileID := profile.ID
 	r.ParseForm()
 
 	type Page struct {
-		Page              string          `json:"-"`
-		Title             string          `json:"-"`
-		Message           Message         `json:"-"`
-		UID               account.UID     `json:"-"`
-		Profile           account.Profile `json:"-"`
-		EditMode          bool            `json:"-"`
-		SelectedTag       string          `json:",omitempty"`
+		Page              string             `json:"-"`
+		Title             string             `json:"-"`
+		Message           Message            `json:"-"`
+		UID               account.UID        `json:"-"`
+		Key               string             `json:"-"` // share key
+		Role              account.Role       `json:"-"`
+		Roles             []account.Role     `json:"-"`
+		Positions         []account.Position `json:"-"`
+		Profile           account.Profile    `json:"-"`
+		EditMode          bool               `json:"-"`
+		SelectedTag       string             `json:",omitempty"`
 		Bookmarks         account.Bookmarks
 		ArchivedBookmarks account.Bookmarks
-		SupportedDomains  []string `json:"-"`
+		SupportedDomains  []string           `json:"-"`
+		SearchBodies      []legislature.Body `json:"-"`
 	}
 	body := Page{
 		Message:           message,
 		Title:             profile.Name + " (legislation.support)",
 		Profile:           *profile,
-		EditMode:          uid == profile.UID,
+		Role:              profile.Role(uid),
+		Roles:             account.Roles,
+		Positions:         account.Positions,
+		EditMode:          profile.Role(uid).CanEdit(),
 		UID:               uid,
+		Key:               key,
 		SelectedTag:       r.Form.Get("tag"),
 		Bookmarks:         make(account.Bookmarks, 0),
 		ArchivedBookmarks: make(account.Bookmarks, 0),
@@ -221,8 +278,10 @@ func (a *App) ShowProfile(w http.ResponseWriter, ctx context.Context, r *http.Re
 	}
 	body.SupportedDomains = append(body.SupportedDomains, metadatasites.SupportedDomains()...)
 	sort.Strings(body.SupportedDomains)
+	body.SupportedDomains = slices.Compact(body.SupportedDomains)
 
 	if body.EditMode {
+		body.SearchBodies = resolvers.SearchBodies()
 		templateName = "profile_edit.html"
 		t = newTemplate(a.templateFS, "profile_edit.html")
 	}
@@ -233,7 +292,7 @@ func (a *App) ShowProfile(w http.ResponseWriter, ctx context.Context, r *http.Re
 		return
 	}
 	for _, bb := range b {
-		if bb.Legislation.Session.Active() {
+		if bb.Legislation.Active() {
 			body.Bookmarks = append(body.Bookmarks, bb)
 		} else {
 			body.ArchivedBookmarks = append(body.ArchivedBookmarks, bb)
@@ -249,7 +308,7 @@ func (a *App) ShowProfile(w http.ResponseWriter, ctx context.Context, r *http.Re
 			}
 		}
 		if !hasTag {
-			http.Redirect(w, r, profile.Link(), 302)
+			http.Redirect(w, r, profile.Link()+keyQuery(key), 302)
 			return
 		}
 	}
@@ -324,6 +383,10 @@ func (a *App) ProfilePost(w http.ResponseWriter, r *http.Request) {
 		apiresponse.OK200(w, m)
 		return
 	case strings.TrimSpace(r.Form.Get("name")) != "":
+		if !profile.Role(uid).CanManage() {
+			a.WebPermissionError403(w, "")
+			return
+		}
 		err = a.ProfileEdit(ctx, *profile, r)
 		newUrl := account.ProfileID(strings.TrimSpace(r.Form.Get("new_url")))
 		if err == nil {
@@ -357,12 +420,40 @@ func (a *App) ProfileEdit(ctx context.Context, p account.Profile, r *http.Reques
 	p.HideSupportOppose = r.Form.Get("hide_support_oppose") == "on"
 	p.HideBillStatus = r.Form.Get("hide_bill_status") == "on"
 	p.HideParty = r.Form.Get("hide_party") == "on"
+	policy := p.Policy()
+	formPoints(r, "sponsor_points", &policy.SponsorPoints)
+	formPoints(r, "prime_sponsor_bonus", &policy.PrimeSponsorBonus)
+	formPoints(r, "vote_points", &policy.VotePoints)
+	policy.AbsentCountsAgainst = r.Form.Get("absent_counts_against") == "on"
+	p.SetPolicy(policy)
 	return a.UpdateProfile(ctx, p)
 }
 
+// formPoints sets v to a number of points (see legislature.ValidPoints); blank or invalid values are unchanged
+func formPoints(r *http.Request, name string, v *float64) {
+	if f, err := strconv.ParseFloat(strings.TrimSpace(r.Form.Get(name)), 64); err == nil && legislature.ValidPoints(f) {
+		*v = f
+	}
+}
+
 func (a *App) ProfilePostURL(ctx context.Context, profileID account.ProfileID, r *http.Request) []*BookmarkChange {
 	uid := a.User(r)
-	input := strings.Fields(strings.TrimSpace(r.Form.Get("legislation_url")))
+	input := resolvers.SplitInput(r.Form.Get("legislation_url"))
+	edit := account.BookmarkEdit{
+		Position: ptr(formPosition(r)),
+		Notes:    ptr(strings.TrimSpace(r.Form.Get("notes"))),
+		Tags:     ptr(strings.Fields(r.Form.Get("tags"))),
+	}
+	if r.Form.Has("rank") {
+		// blank (or invalid) is unranked
+		rank, _ := strconv.Atoi(strings.TrimSpace(r.Form.Get("rank")))
+		edit.Rank = &rank
+	}
+	if r.Form.Has("weight") {
+		// blank (or invalid) is the default weight
+		weight, _ := strconv.Atoi(strings.TrimSpace(r.Form.Get("weight")))
+		edit.Weight = &weight
+	}
 	output := make([]*BookmarkChange, len(input))
 
 	var wg sync.WaitGroup
@@ -372,94 +463,155 @@ func (a *App) ProfilePostURL(ctx context.Context, profileID account.ProfileID, r
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			o := &BookmarkChange{
-				URL: legUrl,
+			output[i] = a.saveBookmark(ctx, uid, profileID, legUrl, edit)
+		}()
+	}
+	wg.Wait()
+	return output
+
+}
+
+// formPosition reads position=watch (etc) or the support=👍, 👎 or 👀 (watch) buttons
+func formPosition(r *http.Request) account.Position {
+	if p := account.Position(r.Form.Get("position")); p.IsValid() {
+		return p
+	}
+	switch r.Form.Get("support") {
+	case "👎":
+		return account.OpposePosition
+	case "👀":
+		return account.WatchPosition
+	}
+	return account.SupportPosition
+}
+
+// saveBookmark looks up the legislation for input (a URL or citation) and adds (or updates) the bookmark
+func (a *App) saveBookmark(ctx context.Context, uid account.UID, profileID account.ProfileID, input string, edit account.BookmarkEdit) *BookmarkChange {
+	o := &BookmarkChange{
+		URL: input,
+	}
+	o.record(func() error {
+		if err := edit.Validate(); err != nil {
+			return err
+		}
+		bill, err := resolvers.LookupInput(ctx, input)
+		if err != nil {
+			return err
+		}
+		body := resolvers.Bodies[bill.Body]
+
+		// Save refreshes a bill as well
+		var staleSameAs bool
+		staleSameAs, err = a.SaveBill(ctx, *bill)
+		if err != nil {
+			return err
+		}
+		if staleSameAs {
+			// refresh the sameAs bill (if needed)
+			sameBill, err := resolvers.Resolvers.Find(body.Bicameral).Refresh(ctx, bill.SameAs)
+			if err != nil {
+				return err
 			}
-			output[i] = o
-			o.record(func() error {
-				var u, matchedURL *url.URL
-				u, err := url.Parse(legUrl)
-				if err != nil {
-					return err
-				}
-				logFields := log.Fields{"uid": uid, "profileID": profileID, "legislation_url": u.String()}
-				log.WithContext(ctx).WithFields(logFields).Infof("parsed URL host:%s", u.Hostname())
-				var bill *legislature.Legislation
-				matchedURL, err = metadatasites.Lookup(r.Context(), u)
-				if err != nil {
-					log.WithContext(ctx).WithFields(logFields).Errorf("metadatasites.Lookup error %#v", err)
-				}
-				if matchedURL.Hostname() != u.Hostname() {
-					log.WithContext(ctx).WithFields(logFields).Infof("metadatasites found URL %q", matchedURL)
-				}
-				bill, err = resolvers.Lookup(r.Context(), matchedURL)
-				if err != nil {
-					return err
-				}
-				if bill == nil {
-					return fmt.Errorf("legislation matching url %q not found", u)
+			_, err = a.SaveBill(ctx, *sameBill)
+			if err != nil {
+				return err
+			}
+		}
 
-				}
-				body := resolvers.Bodies[bill.Body]
+		o.Bookmark, err = a.GetBookmark(ctx, profileID, account.BookmarkKey(bill.Body, bill.ID))
+		if err != nil {
+			return err
+		}
+		if o.Bookmark != nil {
+			old := *o.Bookmark
+			edit.Apply(o.Bookmark)
 
-				// Save refreshes a bill as well
-				var staleSameAs bool
-				staleSameAs, err = a.SaveBill(ctx, *bill)
-				if err != nil {
-					return err
-				}
-				if staleSameAs {
-					// refresh the sameAs bill (if needed)
-					sameBill, err := resolvers.Resolvers.Find(body.Bicameral).Refresh(ctx, bill.SameAs)
-					if err != nil {
-						return err
-					}
-					_, err = a.SaveBill(ctx, *sameBill)
-					if err != nil {
-						return err
-					}
-				}
+			o.Legislation = bill
+			o.Body = &body
 
-				o.Bookmark, err = a.GetBookmark(ctx, profileID, account.BookmarkKey(bill.Body, bill.ID))
-				if err != nil {
-					return err
-				}
-				if o.Bookmark != nil {
-					o.Bookmark.Notes = strings.TrimSpace(r.Form.Get("notes"))
-					o.Bookmark.Tags = strings.Fields(strings.TrimSpace(r.Form.Get("tags")))
-					o.Bookmark.Oppose = r.Form.Get("support") == "👎"
+			// update
+			if err = a.UpdateBookmark(ctx, profileID, *o.Bookmark); err != nil {
+				return err
+			}
+			a.recordBookmarkHistory(ctx, uid, profileID, &old, o.Bookmark)
+			return nil
+		}
+		// TODO: check for a bookmark of the "same-as" bill
 
-					o.Legislation = bill
-					o.Body = &body
+		o.New = true
+		o.Bookmark = &account.Bookmark{
+			UID:           uid,
+			BodyID:        bill.Body,
+			LegislationID: bill.ID,
+			Created:       time.Now().UTC(),
 
-					// update
-					return a.UpdateBookmark(ctx, profileID, *o.Bookmark)
-				}
-				// TODO: check for a bookmark of the "same-as" bill
-
-				o.Bookmark = &account.Bookmark{
-					UID:           uid,
-					BodyID:        bill.Body,
-					LegislationID: bill.ID,
-					Oppose:        r.Form.Get("support") == "👎",
-					Created:       time.Now().UTC(),
-					Notes:         strings.TrimSpace(r.Form.Get("notes")),
-					Tags:          strings.Fields(strings.TrimSpace(r.Form.Get("tags"))),
-
-					Legislation: bill,
-					Body:        &body,
-				}
-				err = a.SaveBookmark(ctx, profileID, *o.Bookmark)
-				if err != nil && !datastore.IsAlreadyExists(err) {
-					return err
-				}
-				return nil
-			}())
-		}()
+			Legislation: bill,
+			Body:        &body,
+		}
+		if !edit.Created.IsZero() {
+			o.Bookmark.Created = edit.Created
+		}
+		edit.Apply(o.Bookmark)
+		err = a.SaveBookmark(ctx, profileID, *o.Bookmark)
+		if datastore.IsAlreadyExists(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		a.recordBookmarkHistory(ctx, uid, profileID, nil, o.Bookmark)
+		return nil
+	}())
+	return o
+}
+
+// ProfileRankPost sets the priority order of a profile's bookmarks
+// POST /data/profile/rank
+//
+// profile_id=... key=body.legislation (repeated, in priority order)
+func (a *App) ProfileRankPost(w http.ResponseWriter, r *http.Request) {
+	r.ParseMultipartForm(1 << 20)
+	ctx := r.Context()
+	uid := a.User(r)
+
+	profileID := account.ProfileID(r.Form.Get("profile_id"))
+	logFields := log.Fields{"uid": uid, "profileID": profileID}
+
+	profile, err := a.GetProfile(ctx, profileID)
+	if err != nil {
+		log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+		apiresponse.InternalError500(w)
+		return
+	}
+	if profile == nil {
+		apiresponse.NotFound404(w)
+		return
+	}
+	if !profile.Role(uid).CanEdit() {
+		apiresponse.Error(w, "Permission Denied", http.StatusForbidden)
+		return
 	}
-	wg.Wait()
-	return output
 
+	bookmarks, err := a.GetProfileBookmarks(ctx, profileID)
+	if err != nil {
+		log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+		apiresponse.InternalError500(w)
+		return
+	}
+	old := make(map[string]account.Bookmark, len(bookmarks))
+	for _, b := range bookmarks {
+		old[b.Key()] = b
+	}
+	for _, b := range bookmarks.Rank(r.Form["key"]) {
+		if err = a.UpdateBookmark(ctx, profileID, b); err != nil {
+			log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+			apiresponse.InternalError500(w)
+			return
+		}
+		o := old[b.Key()]
+		a.recordBookmarkHistory(ctx, uid, profileID, &o, &b)
+	}
+	apiresponse.OK200(w, Message{Success: "Priorities updated"})
 }
 
 // ProfileRemove removes a bookmark from a profile
@@ -490,12 +642,19 @@ func (a *App) ProfileRemove(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	old, err := a.GetBookmark(ctx, profileID, account.BookmarkKey(body, legID))
+	if err != nil {
+		log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
+		http.Error(w, err.Error(), 500)
+		return
+	}
 	err = a.DeleteBookmark(ctx, profileID, body, legID)
 	if err != nil {
 		log.WithContext(ctx).WithFields(logFields).Errorf("%#v", err)
 		http.Error(w, err.Error(), 500)
 		return
 	}
+	a.recordBookmarkHistory(ctx, uid, profileID, old, nil)
 	http.Redirect(w, r, profile.Link(), 302)
 	return
 }
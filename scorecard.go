@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"path"
 	"sort"
 
 	"github.com/jehiah/legislation.support/internal/account"
@@ -29,6 +31,14 @@ func (a *App) Scorecard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	bodyID := legislature.BodyID(r.PathValue("body"))
+	// /{profile}/scorecard/{body}.xlsx and .csv are downloads
+	ext := path.Ext(string(bodyID))
+	switch ext {
+	case ".xlsx", ".csv":
+		bodyID = bodyID[:len(bodyID)-len(ext)]
+	default:
+		ext = ""
+	}
 	if !resolvers.IsValidBodyID(bodyID) {
 		http.Error(w, "Not Found", 404)
 		return
@@ -48,8 +58,8 @@ func (a *App) Scorecard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if uid == "" && profile.Private {
-		a.WebPermissionError403(w, "")
+	key, ok := a.canView(w, r, uid, *profile)
+	if !ok {
 		return
 	}
 
@@ -57,6 +67,8 @@ func (a *App) Scorecard(w http.ResponseWriter, r *http.Request) {
 		Page     string
 		Title    string
 		UID      account.UID
+		Key      string // share key
+		Query    string // the query string for download links
 		Profile  account.Profile
 		EditMode bool
 		*legislature.Scorecard
@@ -85,45 +97,37 @@ func (a *App) Scorecard(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Not Found", 404)
 		return
 	}
+	if body.Unavailable {
+		a.WebError(w, http.StatusServiceUnavailable, body.Name+" is temporarily unavailable")
+		return
+	}
 
 	pageBody := Page{
 		Title:    profile.Name + " " + body.Name + " Scorecard",
 		Profile:  *profile,
-		EditMode: uid == profile.UID,
+		EditMode: profile.Role(uid).CanEdit(),
 		UID:      uid,
+		Key:      key,
 	}
-
-	// bookmarks := b.Active().Filter(body.ID)
-	bookmarks := b.Active().Filter(body.ID, body.Bicameral)
-
-	if t := r.Form.Get("tag"); t != "" {
-		bookmarks = bookmarks.FilterTag(t)
-		pageBody.Title = fmt.Sprintf("%s %s Scorecard %s", profile.Name, body.Name, t)
+	if q := r.URL.Query(); len(q) > 0 {
+		q.Del("view")
+		if len(q) > 0 {
+			pageBody.Query = "?" + q.Encode()
+		}
 	}
 
-	sort.Sort(account.SortedBookmarks(bookmarks))
-	var scorable []legislature.Scorable
-	for _, b := range bookmarks {
-		scorable = append(scorable, b)
+	tag := r.Form.Get("tag")
+	if tag != "" {
+		pageBody.Title = fmt.Sprintf("%s %s Scorecard %s", profile.Name, body.Name, tag)
 	}
-	pageBody.Scorecard, err = resolvers.Resolvers.Find(body.ID).Scorecard(ctx, scorable)
+
+	pageBody.Scorecard, pageBody.PersonWhipCounts, err = buildScorecard(ctx, b, body, tag, profile.ScorecardOptions)
 	if err != nil {
 		log.WithFields(fields).Errorf("%#v, %#v", err, errors.Unwrap(err))
 		a.WebInternalError500(w, "")
 		return
 	}
 
-	for i, p := range pageBody.People {
-		pageBody.PersonWhipCounts = append(pageBody.PersonWhipCounts, legislature.PersonWhipCount{
-			ScorecardPerson: p,
-			WhipCount:       pageBody.Scorecard.WhipCount(i),
-		})
-	}
-
-	sort.Slice(pageBody.PersonWhipCounts, func(i, j int) bool {
-		return pageBody.PersonWhipCounts[i].WhipCount.Percent() > pageBody.PersonWhipCounts[j].WhipCount.Percent()
-	})
-
 	// if no party, hide the party column
 	hasParty := false
 	for _, p := range pageBody.PersonWhipCounts {
@@ -136,6 +140,20 @@ func (a *App) Scorecard(w http.ResponseWriter, r *http.Request) {
 		pageBody.Profile.HideParty = true
 	}
 
+	if ext != "" {
+		rows := scorecardTable(pageBody.Scorecard, pageBody.Profile.ScorecardOptions)
+		filename := scorecardFilename(profile.ID, body.ID, tag, ext)
+		if ext == ".csv" {
+			err = a.ScorecardCSV(w, filename, rows)
+		} else {
+			err = a.ScorecardXLSX(w, filename, "Scorecard", len(rows)-len(pageBody.People), rows)
+		}
+		if err != nil {
+			log.WithFields(fields).Errorf("%#v", err)
+		}
+		return
+	}
+
 	// log.Printf("bookmarks %#v", body.Bookmarks)
 
 	err = t.ExecuteTemplate(w, templateName, pageBody)
@@ -144,3 +162,24 @@ func (a *App) Scorecard(w http.ResponseWriter, r *http.Request) {
 		a.WebInternalError500(w, "")
 	}
 }
+
+// buildScorecard scores the active supported and opposed bookmarks for body (optionally filtered by tag) with the profile
+// scoring policy and returns the whip counts for each person sorted by weighted score
+func buildScorecard(ctx context.Context, b account.Bookmarks, body legislature.Body, tag string, o account.ScorecardOptions) (*legislature.Scorecard, []legislature.PersonWhipCount, error) {
+	bookmarks := b.Active().Scored().Filter(body.ID, body.Bicameral)
+	if tag != "" {
+		bookmarks = bookmarks.FilterTag(tag)
+	}
+
+	sort.Sort(account.SortedBookmarks(bookmarks))
+	var scorable []legislature.Scorable
+	for _, b := range bookmarks {
+		scorable = append(scorable, b)
+	}
+	scorecard, err := resolvers.Resolvers.Find(body.ID).Scorecard(ctx, scorable)
+	if err != nil {
+		return nil, nil, err
+	}
+	scorecard.Policy = o.ScoringPolicy
+	return scorecard, scorecard.PersonWhipCounts(), nil
+}
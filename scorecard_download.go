@@ -0,0 +1,182 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/jehiah/legislation.support/internal/account"
+	"github.com/jehiah/legislation.support/internal/legislature"
+	"github.com/xuri/excelize/v2"
+)
+
+// percent is a scorecard cell formatted as a percent (i.e. 12.5 is 12.5%)
+type percent float64
+
+func (p percent) String() string { return fmt.Sprintf("%0.1f%%", float64(p)) }
+
+// scorecardTable lays out a scorecard for a spreadsheet like the HTML scorecard: a few rows describing
+// each bill, then one row per person sorted by weighted score. The ScorecardOptions hide flags omit
+// the matching rows and columns.
+//
+// Cells are a string, int or percent
+func scorecardTable(s *legislature.Scorecard, o account.ScorecardOptions) [][]any {
+	// label columns before the legislation columns
+	label := func(name string, district, party, score, grade any) []any {
+		row := []any{name}
+		if !o.HideDistrict {
+			row = append(row, district)
+		}
+		if !o.HideParty {
+			row = append(row, party)
+		}
+		return append(row, score, grade)
+	}
+
+	title := label("Title", "", "", "", "")
+	id := label("Legislation", "", "", "", "")
+	status := label("Status", "", "", "", "")
+	position := label("Position", "", "", "", "")
+	weight := label("Weight", "", "", "", "")
+	header := label(s.Metadata.PersonTitle, "District", "Party", "Score", "Grade")
+	for _, d := range s.Data {
+		l := d.Legislation
+		title = append(title, l.Title)
+		displayID := LegislationDisplayID(l.Body, l.ID)
+		if l.SameAs != "" {
+			if s.Body != nil && s.Body.UpperHouse {
+				displayID += " / " + LegislationDisplayID(l.Body, l.SameAs)
+			} else {
+				displayID = LegislationDisplayID(l.Body, l.SameAs) + " / " + displayID
+			}
+		}
+		id = append(id, displayID)
+		status = append(status, d.Status)
+		if d.Oppose {
+			position = append(position, "Oppose")
+		} else {
+			position = append(position, "Support")
+		}
+		weight = append(weight, max(d.Weight, 1))
+		header = append(header, percent(d.WhipCount().PercentCorrect()))
+	}
+
+	out := [][]any{title, id}
+	if !o.HideBillStatus {
+		out = append(out, status)
+	}
+	if !o.HideSupportOppose {
+		out = append(out, position)
+	}
+	if s.Weighted() {
+		out = append(out, weight)
+	}
+	out = append(out, header)
+
+	people := make([]int, len(s.People))
+	for i := range people {
+		people[i] = i
+	}
+	sort.SliceStable(people, func(i, j int) bool {
+		return s.WeightedScore(people[i]).Percent() > s.WeightedScore(people[j]).Percent()
+	})
+	for _, i := range people {
+		p := s.People[i]
+		score := s.WeightedScore(i)
+		row := label(p.FullName, p.District, p.Party, percent(score.Percent()), score.Grade())
+		for _, d := range s.Data {
+			row = append(row, d.Scores[i].Status)
+		}
+		out = append(out, row)
+	}
+	return out
+}
+
+// scorecardFilename is the download filename i.e. "my-profile-nyc-housing.xlsx"
+func scorecardFilename(profileID account.ProfileID, body legislature.BodyID, tag, ext string) string {
+	name := string(profileID) + "-" + string(body)
+	if tag != "" {
+		name += "-" + strings.Join(strings.FieldsFunc(tag, func(r rune) bool {
+			return !(r == '-' || r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9'))
+		}), "-")
+	}
+	return name + ext
+}
+
+// ScorecardCSV writes a scorecard as CSV
+func (a *App) ScorecardCSV(w http.ResponseWriter, filename string, rows [][]any) error {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	cw := csv.NewWriter(w)
+	for _, row := range rows {
+		record := make([]string, len(row))
+		for i, c := range row {
+			record[i] = fmt.Sprint(c)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ScorecardXLSX writes a scorecard as an Excel spreadsheet
+func (a *App) ScorecardXLSX(w http.ResponseWriter, filename, sheet string, headerRows int, rows [][]any) error {
+	f := excelize.NewFile()
+	defer f.Close()
+	if err := f.SetSheetName("Sheet1", sheet); err != nil {
+		return err
+	}
+	percentStyle, err := f.NewStyle(&excelize.Style{NumFmt: 177, CustomNumFmt: ptr("0.0%")})
+	if err != nil {
+		return err
+	}
+	boldStyle, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return err
+	}
+
+	for r, row := range rows {
+		for c, v := range row {
+			cell, err := excelize.CoordinatesToCellName(c+1, r+1)
+			if err != nil {
+				return err
+			}
+			switch v := v.(type) {
+			case percent:
+				err = f.SetCellFloat(sheet, cell, float64(v)/100, 3, 64)
+				if err == nil {
+					err = f.SetCellStyle(sheet, cell, cell, percentStyle)
+				}
+			default:
+				err = f.SetCellValue(sheet, cell, v)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if r < headerRows && len(row) > 0 {
+			first, _ := excelize.CoordinatesToCellName(1, r+1)
+			last, _ := excelize.CoordinatesToCellName(len(row), r+1)
+			if err = f.SetCellStyle(sheet, first, last, boldStyle); err != nil {
+				return err
+			}
+		}
+	}
+	// keep the bill rows and the person name visible while scrolling
+	topLeft, _ := excelize.CoordinatesToCellName(2, headerRows+1)
+	err = f.SetPanes(sheet, &excelize.Panes{Freeze: true, XSplit: 1, YSplit: headerRows, TopLeftCell: topLeft, ActivePane: "bottomRight"})
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	_, err = f.WriteTo(w)
+	return err
+}
+
+func ptr[T any](v T) *T { return &v }
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+)
+
+func TestScorecardXLSXHeaderStyle(t *testing.T) {
+	rows := [][]any{
+		{"Title", "", "Bill One", "Bill Two"},
+		{"Legislation", "", "S1", "S2"},
+		{"Jane Doe", percent(50), "Aye", "Nay"},
+	}
+	w := httptest.NewRecorder()
+	if err := (&App{}).ScorecardXLSX(w, "x.xlsx", "Scorecard", 2, rows); err != nil {
+		t.Fatal(err)
+	}
+	f, err := excelize.OpenReader(w.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	bold := func(cell string) bool {
+		id, err := f.GetCellStyle("Scorecard", cell)
+		if err != nil {
+			t.Fatal(err)
+		}
+		style, err := f.GetStyle(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return style.Font != nil && style.Font.Bold
+	}
+	for cell, expected := range map[string]bool{"A1": true, "D1": true, "C2": true, "A3": false, "D3": false} {
+		if got := bold(cell); got != expected {
+			t.Errorf("%s got bold %v expected %v", cell, got, expected)
+		}
+	}
+}
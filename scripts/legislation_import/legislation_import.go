@@ -4,9 +4,7 @@ import (
 	"context"
 	"encoding/csv"
 	"flag"
-	"fmt"
 	"io"
-	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -14,14 +12,13 @@ import (
 
 	"github.com/jehiah/legislation.support/internal/account"
 	"github.com/jehiah/legislation.support/internal/datastore"
-	"github.com/jehiah/legislation.support/internal/legislature"
-	"github.com/jehiah/legislation.support/internal/metadatasites"
 	"github.com/jehiah/legislation.support/internal/resolvers"
 	log "github.com/sirupsen/logrus"
 )
 
+// Row is a CSV row: URL or citation (i.e. "S1234"), support, tags, notes
 type Row struct {
-	URL     *url.URL
+	Input   string // a URL or citation
 	Support bool
 	Tags    []string
 	Notes   string
@@ -30,12 +27,20 @@ type Row struct {
 func main() {
 	inputFile := flag.String("input", "", "input csv file")
 	profileID := flag.String("profile", "", "profile name")
+	dsn := flag.String("datastore", "firestore", datastore.DSNUsage)
+	configFile := flag.String("config", "", "legislative bodies config file (default: ENV LEGISLATION_SUPPORT_CONFIG or the built-in config)")
 	flag.Parse()
 	if *inputFile == "" {
 		log.Fatal("input file is required")
 	}
+	if err := resolvers.Load(*configFile); err != nil {
+		log.Fatal(err)
+	}
 	ctx := context.Background()
-	db := datastore.New(datastore.NewClient(ctx))
+	db, err := datastore.Open(ctx, *dsn)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	// validate profile
 	profile, err := db.GetProfile(ctx, account.ProfileID(*profileID))
@@ -65,14 +70,10 @@ func main() {
 		}
 		// save row
 		record := Row{
+			Input: strings.TrimSpace(row[0]),
 			Tags:  strings.Split(row[2], ","),
 			Notes: row[3],
 		}
-		record.URL, err = url.Parse(row[0])
-		if err != nil {
-			log.Errorf("error parsing url %s: %s", row[0], err)
-			continue
-		}
 		if row[1] != "" {
 			record.Support, _ = strconv.ParseBool(row[1])
 		} else {
@@ -81,32 +82,19 @@ func main() {
 
 		err = Save(db, profile.ID, record)
 		if err != nil {
-			log.Errorf("error saving %s: %s", record.URL, err)
+			log.Errorf("error saving %s: %s", row[0], err)
 		}
 		time.Sleep(100 * time.Millisecond)
 	}
 
 }
 
-func Save(db *datastore.Datastore, profileID account.ProfileID, row Row) error {
+func Save(db datastore.Store, profileID account.ProfileID, row Row) error {
 	ctx := context.Background()
-	u := row.URL
-	logFields := log.Fields{"profileID": profileID, "legislation_url": u.String()}
-	var bill *legislature.Legislation
-	matchedURL, err := metadatasites.Lookup(ctx, u)
-	if err != nil {
-		log.WithContext(ctx).WithFields(logFields).Errorf("metadatasites.Lookup error %#v", err)
-	}
-	if matchedURL.Hostname() != u.Hostname() {
-		log.WithContext(ctx).WithFields(logFields).Infof("metadatasites found URL %q", matchedURL)
-	}
-	bill, err = resolvers.Lookup(ctx, matchedURL)
+	bill, err := resolvers.LookupInput(ctx, row.Input)
 	if err != nil {
 		return err
 	}
-	if bill == nil {
-		return fmt.Errorf("legislation matching url %q not found", u)
-	}
 	body := resolvers.Bodies[bill.Body]
 
 	// Save refreshes a bill as well
@@ -127,6 +115,11 @@ func Save(db *datastore.Datastore, profileID account.ProfileID, row Row) error {
 		}
 	}
 
+	position := account.SupportPosition
+	if !row.Support {
+		position = account.OpposePosition
+	}
+
 	bookmark, err := db.GetBookmark(ctx, profileID, account.BookmarkKey(bill.Body, bill.ID))
 	if err != nil {
 		return err
@@ -134,7 +127,7 @@ func Save(db *datastore.Datastore, profileID account.ProfileID, row Row) error {
 	if bookmark != nil {
 		bookmark.Notes = row.Notes
 		bookmark.Tags = row.Tags
-		bookmark.Oppose = !row.Support
+		bookmark.SetPosition(position)
 
 		// update
 		return db.UpdateBookmark(ctx, profileID, *bookmark)
@@ -148,7 +141,7 @@ func Save(db *datastore.Datastore, profileID account.ProfileID, row Row) error {
 		if bookmark != nil {
 			bookmark.Notes = row.Notes
 			bookmark.Tags = row.Tags
-			bookmark.Oppose = !row.Support
+			bookmark.SetPosition(position)
 
 			// update
 			return db.UpdateBookmark(ctx, profileID, *bookmark)
@@ -158,7 +151,8 @@ func Save(db *datastore.Datastore, profileID account.ProfileID, row Row) error {
 	bookmark = &account.Bookmark{
 		BodyID:        bill.Body,
 		LegislationID: bill.ID,
-		Oppose:        !row.Support,
+		Oppose:        position == account.OpposePosition,
+		Position:      position,
 		Created:       time.Now().UTC(),
 		Notes:         row.Notes,
 		Tags:          row.Tags,
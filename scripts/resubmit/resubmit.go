@@ -26,6 +26,12 @@ type SameAs struct {
 	PreviousVersions []string `json:",omitempty"`
 }
 
+const (
+	nysenateID   legislature.BodyID = "nysenate"
+	nyAssemblyID legislature.BodyID = "ny-assembly"
+	nycID        legislature.BodyID = "nyc"
+)
+
 func flipNyPrintNo(s string) legislature.LegislationID {
 	a, b, _ := strings.Cut(s, "-")
 	return legislature.LegislationID(b + "-" + a)
@@ -33,9 +39,9 @@ func flipNyPrintNo(s string) legislature.LegislationID {
 
 func supportsResubmit(bodyID legislature.BodyID) bool {
 	switch bodyID {
-	case resolvers.NYSenate.ID:
-	case resolvers.NYAssembly.ID:
-	case resolvers.NYCCouncil.ID:
+	case nysenateID:
+	case nyAssemblyID:
+	case nycID:
 	default:
 		return false
 	}
@@ -60,18 +66,18 @@ func buildNYResubmitMapping(nyLegislationPath string) legislature.ResubmitMappin
 		for printNo, sameAs := range index {
 			for _, prevPrintNo := range sameAs.PreviousVersions {
 				prev := legislature.GlobalID{
-					BodyID:        resolvers.NYSenate.ID,
+					BodyID:        nysenateID,
 					LegislationID: flipNyPrintNo(prevPrintNo),
 				}
 				current := legislature.GlobalID{
-					BodyID:        resolvers.NYSenate.ID,
+					BodyID:        nysenateID,
 					LegislationID: flipNyPrintNo(printNo),
 				}
 				if strings.HasPrefix(prevPrintNo, "A") {
-					prev.BodyID = resolvers.NYAssembly.ID
+					prev.BodyID = nyAssemblyID
 				}
 				if strings.HasPrefix(printNo, "A") {
-					current.BodyID = resolvers.NYAssembly.ID
+					current.BodyID = nyAssemblyID
 				}
 				mapping[prev] = current
 			}
@@ -81,7 +87,7 @@ func buildNYResubmitMapping(nyLegislationPath string) legislature.ResubmitMappin
 }
 func buildNYCReesubmitMapping(ctx context.Context) legislature.ResubmitMapping {
 	m := make(legislature.ResubmitMapping)
-	nycAPI := nyc.New(resolvers.NYCCouncil)
+	nycAPI := nyc.New(resolvers.Bodies[nycID])
 	currentYear := time.Now().Year()
 	for year := 2020; year <= currentYear; year++ {
 		v, err := nycAPI.Resubmit(context.Background(), year)
@@ -97,10 +103,18 @@ func main() {
 	nyLegislationPath := flag.String("ny-legislation-path", "../../../ny_legislation", "path to ny-legislation repo")
 	profileIDStr := flag.String("profile-id", "jehiah-nyc", "profile id")
 	dryRun := flag.Bool("dry-run", false, "dry run")
+	dsn := flag.String("datastore", "firestore", datastore.DSNUsage)
+	configFile := flag.String("config", "", "legislative bodies config file (default: ENV LEGISLATION_SUPPORT_CONFIG or the built-in config)")
 	flag.Parse()
 	log.SetFormatter(&log.TextFormatter{TimestampFormat: tsFmt, FullTimestamp: true})
+	if err := resolvers.Load(*configFile); err != nil {
+		log.Fatal(err)
+	}
 	ctx := context.Background()
-	db := datastore.New(datastore.NewClient(ctx))
+	db, err := datastore.Open(ctx, *dsn)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	mapping := buildNYResubmitMapping(*nyLegislationPath)
 	mapping.Extend(buildNYCReesubmitMapping(ctx))
@@ -125,7 +139,7 @@ func main() {
 		log.WithField("profileID", profileID).Fatalf("%s", err)
 	}
 	for _, bb := range b {
-		if bb.Legislation.Session.Active() || !supportsResubmit(bb.BodyID) {
+		if bb.Legislation.Active() || !supportsResubmit(bb.BodyID) {
 			continue
 		}
 		records = append(records, bb)
@@ -216,6 +230,7 @@ func main() {
 			Tags:          record.Tags,
 			Notes:         record.Notes,
 			Oppose:        record.Oppose,
+			Position:      record.Position,
 			Legislation:   bill,
 			Body:          &body,
 		})
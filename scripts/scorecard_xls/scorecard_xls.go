@@ -19,11 +19,19 @@ import (
 func main() {
 	profileIDStr := flag.String("profile-id", "test-jehiah", "profile id")
 	bodyStr := flag.String("body", "us-house", "legislative body")
+	dsn := flag.String("datastore", "firestore", datastore.DSNUsage)
+	configFile := flag.String("config", "", "legislative bodies config file (default: ENV LEGISLATION_SUPPORT_CONFIG or the built-in config)")
 	flag.Parse()
 	log.SetFormatter(&log.TextFormatter{TimestampFormat: "2006/01/02 15:04:05", FullTimestamp: true})
 	log.SetLevel(log.DebugLevel)
+	if err := resolvers.Load(*configFile); err != nil {
+		log.Fatal(err)
+	}
 	ctx := context.Background()
-	db := datastore.New(datastore.NewClient(ctx))
+	db, err := datastore.Open(ctx, *dsn)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	profileID := account.ProfileID(*profileIDStr)
 	if !account.IsValidProfileID(profileID) {
@@ -108,8 +116,8 @@ type billData struct {
 // buildHouseScorecardData fetches the list of House members and, for each
 // bookmarked bill, the sponsors and recorded votes.
 func buildHouseScorecardData(ctx context.Context, api *congress.CongressAPI, bookmarks account.Bookmarks) ([]legislature.Member, []billData, error) {
-	houseAPI := congress.NewHouse(resolvers.USHouse, "")
-	people, err := houseAPI.Members(ctx, congress.Sessions.Current())
+	houseAPI := congress.NewHouse(resolvers.Bodies["us-house"], "")
+	people, err := houseAPI.Members(ctx, congress.Calendar.Current())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -144,9 +152,9 @@ func buildHouseScorecardData(ctx context.Context, api *congress.CongressAPI, boo
 		}
 
 		for _, action := range actions {
-			// if isVoteIrrelevant(action.Text) {
-			// 	continue
-			// }
+			if action.IsVoteIrelevant() {
+				continue
+			}
 			for _, rv := range action.RecordedVotes {
 				if !isHouseChamber(rv.Chamber) {
 					continue
@@ -262,14 +270,6 @@ func setCell(f *excelize.File, sheet string, col, row int, value interface{}) er
 	return f.SetCellValue(sheet, cell, value)
 }
 
-func isVoteIrrelevant(text string) bool {
-	switch {
-	case strings.HasPrefix(text, "Motion to reconsider laid on the table"):
-		return true
-	}
-	return false
-}
-
 func isHouseChamber(chamber string) bool {
 	if chamber == "" {
 		return true
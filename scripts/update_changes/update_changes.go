@@ -5,11 +5,10 @@ import (
 	"flag"
 	"time"
 
-	"cloud.google.com/go/firestore"
 	"github.com/jehiah/legislation.support/internal/datastore"
 	"github.com/jehiah/legislation.support/internal/legislature"
+	"github.com/jehiah/legislation.support/internal/resolvers"
 	log "github.com/sirupsen/logrus"
-	"google.golang.org/api/iterator"
 )
 
 // tsFmt is used to match logrus timestamp format
@@ -24,52 +23,42 @@ type Record struct {
 func main() {
 	// limit := flag.Int("limit", 500, "limit")
 	dryRun := flag.Bool("dry-run", false, "dry run")
+	dsn := flag.String("datastore", "firestore", datastore.DSNUsage)
+	configFile := flag.String("config", "", "legislative bodies config file (default: ENV LEGISLATION_SUPPORT_CONFIG or the built-in config)")
 	flag.Parse()
 	log.SetFormatter(&log.TextFormatter{TimestampFormat: tsFmt, FullTimestamp: true})
+	if err := resolvers.Load(*configFile); err != nil {
+		log.Fatal(err)
+	}
 	ctx := context.Background()
 
 	// iterate changes and delete those thhat are after epoc
 	epoch := time.Date(2024, 6, 11, 0, 0, 0, 0, time.UTC)
 
-	db := datastore.NewClient(ctx)
-	iter := db.CollectionGroup("changes").Documents(ctx)
-	defer iter.Stop()
-	for {
-		doc, err := iter.Next()
-		if err == iterator.Done {
-			break
-		}
-		if err != nil {
-			log.Fatalf("error fetching changes: %s", err)
-		}
-		var changes legislature.Changes
-		err = doc.DataTo(&changes)
-		if err != nil {
-			log.Fatalf("error fetching changes: %s", err)
-		}
-		remove := []any{}
+	db, err := datastore.Open(ctx, *dsn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = db.GetAllChanges(ctx, func(id legislature.GlobalID, changes legislature.Changes) error {
+		var remove []legislature.SponsorChange
 		for _, c := range changes.Sponsors {
 			if c.Date.Before(epoch) {
-				// log.Printf("skip %s %#v", doc.Ref.Path, c)
+				// log.Printf("skip %s %#v", id, c)
 				continue
 			}
 			remove = append(remove, c)
 		}
 		if len(remove) == 0 {
-			continue
+			return nil
 		}
 
+		log.Printf("delete %d changes  %s %#v", len(remove), id, remove)
 		if *dryRun {
-			log.Printf("delete %d changes  %s %#v", len(remove), doc.Ref.Path, remove)
-		} else {
-			log.Printf("delete %d changes  %s %#v", len(remove), doc.Ref.Path, remove)
-			_, err := doc.Ref.Update(ctx, []firestore.Update{
-				{Path: "Sponsors", Value: firestore.ArrayRemove(remove...)},
-			})
-			if err != nil {
-				log.Fatal(err)
-			}
+			return nil
 		}
+		return db.RemoveSponsorChanges(ctx, id, remove)
+	})
+	if err != nil {
+		log.Fatalf("error updating changes: %s", err)
 	}
-
 }
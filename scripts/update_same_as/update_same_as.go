@@ -22,10 +22,18 @@ type Record struct {
 
 func main() {
 	limit := flag.Int("limit", 500, "limit")
+	dsn := flag.String("datastore", "firestore", datastore.DSNUsage)
+	configFile := flag.String("config", "", "legislative bodies config file (default: ENV LEGISLATION_SUPPORT_CONFIG or the built-in config)")
 	flag.Parse()
 	log.SetFormatter(&log.TextFormatter{TimestampFormat: tsFmt, FullTimestamp: true})
+	if err := resolvers.Load(*configFile); err != nil {
+		log.Fatal(err)
+	}
 	ctx := context.Background()
-	db := datastore.New(datastore.NewClient(ctx))
+	db, err := datastore.Open(ctx, *dsn)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	// for each bill check if it's SameAs exists
 	// if it doesn't fetch and save it
@@ -34,8 +42,8 @@ func main() {
 	sameAs := make(map[Record]bool)
 	// get all bills
 
-	err := db.GetAllBills(ctx, func(bill legislature.Legislation) error {
-		if !bill.Session.Active() {
+	err = db.GetAllBills(ctx, func(bill legislature.Legislation) error {
+		if !bill.Active() {
 			return nil
 		}
 		// check if we already have this bill
@@ -3,8 +3,10 @@ package main
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 	"time"
 
+	"firebase.google.com/go/v4/auth"
 	"github.com/jehiah/legislation.support/internal/account"
 	"github.com/jehiah/legislation.support/internal/legislature"
 	"github.com/jehiah/legislation.support/internal/resolvers"
@@ -16,16 +18,46 @@ type SessionRequest struct {
 }
 
 func (a *App) User(r *http.Request) account.UID {
+	if a.firebase == nil {
+		return a.devUser
+	}
+	decoded := a.session(r)
+	if decoded == nil {
+		return ""
+	}
+	return account.UID(decoded.UID)
+}
+
+// UserEmail returns the verified email address of the signed in user
+func (a *App) UserEmail(r *http.Request) string {
+	if a.firebase == nil {
+		return a.devEmail
+	}
+	decoded := a.session(r)
+	if decoded == nil {
+		return ""
+	}
+	if verified, _ := decoded.Claims["email_verified"].(bool); !verified {
+		return ""
+	}
+	email, _ := decoded.Claims["email"].(string)
+	return strings.ToLower(email)
+}
+
+func (a *App) session(r *http.Request) *auth.Token {
+	if a.firebase == nil {
+		return nil
+	}
 	cookie, err := r.Cookie("session")
 	if err != nil {
-		return ""
+		return nil
 	}
 	// VerifySessionCookieAndCheckRevoked would make a server side call to check if it's revoked
 	decoded, err := a.firebase.VerifySessionCookie(r.Context(), cookie.Value)
 	if err != nil {
-		return ""
+		return nil
 	}
-	return account.UID(decoded.UID)
+	return decoded
 }
 
 type BillBody struct {
@@ -97,6 +129,10 @@ func (a *App) SignOut(w http.ResponseWriter, r *http.Request) {
 // NewSession handles POST /data/session at the end of authentication
 func (a *App) NewSession(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
+	if a.firebase == nil {
+		http.Error(w, "Firebase Auth is not configured", 404)
+		return
+	}
 	var body SessionRequest
 	err := json.NewDecoder(r.Body).Decode(&body)
 	if err != nil {